@@ -0,0 +1,380 @@
+package cli
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+var (
+	configGlobal bool
+	configLocal  bool
+	configType   string
+	configOrigin bool
+)
+type configValue struct {
+	Key    string             `json:"key" yaml:"key"`
+	Value  any                `json:"value" yaml:"value"`
+	File   string             `json:"file,omitempty" yaml:"file,omitempty"`
+	Origin *configfile.Source `json:"origin,omitempty" yaml:"origin,omitempty"`
+}
+func (v configValue) Text(w io.Writer) {
+	if v.Origin != nil {
+		fmt.Fprintf(w, "%s\t", v.Origin)
+	}
+	fmt.Fprintln(w, formatConfigValue(v.Value))
+}
+type configEntries []configValue
+func (e configEntries) Text(w io.Writer) {
+	for _, entry := range e {
+		if entry.Origin != nil {
+			fmt.Fprintf(w, "%s\t", entry.Origin)
+		}
+		fmt.Fprintf(w, "%s=%s\n", entry.Key, formatConfigValue(entry.Value))
+	}
+}
+type configChange struct {
+	Action string `json:"action" yaml:"action"`
+	Key    string `json:"key" yaml:"key"`
+	Value  any    `json:"value,omitempty" yaml:"value,omitempty"`
+	File   string `json:"file" yaml:"file"`
+}
+func (c configChange) Text(w io.Writer) {
+	if c.Action == "unset" {
+		fmt.Fprintf(w, "✅ Removed %s from %s\n", c.Key, c.File)
+		return
+	}
+	fmt.Fprintf(w, "✅ %s = %s (%s)\n", c.Key, formatConfigValue(c.Value), c.File)
+}
+type configFile struct {
+	Path string `json:"path" yaml:"path"`
+}
+func (f configFile) Text(w io.Writer) {
+	fmt.Fprintln(w, f.Path)
+}
+type configFileResult struct {
+	Path   string             `json:"path" yaml:"path"`
+	Valid  bool               `json:"valid" yaml:"valid"`
+	Issues []configfile.Issue `json:"issues,omitempty" yaml:"issues,omitempty"`
+}
+type configValidation []configFileResult
+func (v configValidation) Text(w io.Writer) {
+	for _, result := range v {
+		if result.Valid {
+			fmt.Fprintf(w, "✅ %s\n", result.Path)
+			continue
+		}
+		fmt.Fprintf(w, "❌ %s\n", result.Path)
+		for _, issue := range result.Issues {
+			fmt.Fprintf(w, "   ↳ %s\n", issue.Error())
+		}
+	}
+}
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and write dev-tools configuration",
+	Long:  "Manage the global ($XDG_CONFIG_HOME/dev-tools/config.yaml) or project (nearest .dev-tools.yaml in the working directory or its parents) configuration file. Values are layered: defaults < global < project < DEV_TOOLS_* environment variables < flags.",
+}
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the value of a config key",
+	Args:  cobra.ExactArgs(1),
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		result := configValue{Key: args[0]}
+		ok := viper.IsSet(args[0])
+		result.Value = viper.Get(args[0])
+		if configScoped() {
+			store, err := openConfigStore()
+			if err != nil {
+				return nil, err
+			}
+			result.File = store.Path()
+			result.Value, ok = store.Get(args[0])
+		}
+		if !ok {
+			return nil, output.NewError(output.CodeNotFound, fmt.Sprintf("key %q is not set", args[0]), "Run 'dev-tools config list' to see the configured keys")
+		}
+		if configOrigin && !configScoped() {
+			origin := configfile.OriginOf(args[0])
+			result.Origin = &origin
+		}
+		return result, nil
+	}),
+}
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a config key",
+	Args:  cobra.ExactArgs(2),
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		kind := configType
+		if kind == "" {
+			if spec, ok := configfile.LookupKey(args[0]); ok && spec.Key == strings.ToLower(args[0]) && spec.Type != "map" {
+				kind = spec.Type
+			}
+		}
+		value, err := configfile.ParseValue(args[1], kind)
+		if err != nil {
+			return nil, output.NewError(output.CodeInvalidArgument, fmt.Sprintf("invalid value for %s: %v", args[0], err), "Pass --type to choose how the value is parsed")
+		}
+		store, err := openConfigStore()
+		if err != nil {
+			return nil, err
+		}
+		store.Set(args[0], value)
+		if issues := configfile.Validate(store); len(issues) > 0 {
+			return configValidation{{Path: store.Path(), Issues: issues}}, configIssuesError(store.Path(), issues)
+		}
+		if err := saveConfigStore(store); err != nil {
+			return nil, err
+		}
+		return configChange{Action: "set", Key: args[0], Value: value, File: store.Path()}, nil
+	}),
+}
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a config key",
+	Args:  cobra.ExactArgs(1),
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		store, err := openConfigStore()
+		if err != nil {
+			return nil, err
+		}
+		if !store.Unset(args[0]) {
+			return nil, output.NewError(output.CodeNotFound, fmt.Sprintf("key %q is not set in %s", args[0], store.Path()), "")
+		}
+		if err := saveConfigStore(store); err != nil {
+			return nil, err
+		}
+		return configChange{Action: "unset", Key: args[0], File: store.Path()}, nil
+	}),
+}
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List config keys and values",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		keys := configfile.Keys()
+		get := viper.Get
+		file := ""
+		if configScoped() {
+			store, err := openConfigStore()
+			if err != nil {
+				return nil, err
+			}
+			keys = store.Keys()
+			file = store.Path()
+			get = func(key string) any {
+				value, _ := store.Get(key)
+				return value
+			}
+		}
+		sort.Strings(keys)
+		entries := configEntries{}
+		for _, key := range keys {
+			entry := configValue{Key: key, Value: get(key), File: file}
+			if configOrigin && !configScoped() {
+				origin := configfile.OriginOf(key)
+				entry.Origin = &origin
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	}),
+}
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open the config file in $EDITOR",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		path, err := configPath()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return nil, output.NewError(output.CodeCommandFailed, fmt.Sprintf("failed to create %s: %v", filepath.Dir(path), err), "")
+			}
+			if err := os.WriteFile(path, []byte{}, 0o644); err != nil {
+				return nil, output.NewError(output.CodeCommandFailed, fmt.Sprintf("failed to create %s: %v", path, err), "")
+			}
+		}
+		editor := executor.Editor()
+		editorArgs := append(editor[1:], path)
+		if err := executor.NewExecutor().ExecuteInteractive(context.Background(), editor[0], editorArgs...); err != nil {
+			return nil, output.NewError(output.CodeCommandFailed, fmt.Sprintf("editor %s failed: %v", editor[0], err), "Set $EDITOR to the editor you want to use")
+		}
+		return validateConfigPaths([]string{path})
+	}),
+}
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the path of the config file",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		path, err := configPath()
+		if err != nil {
+			return nil, err
+		}
+		return configFile{Path: path}, nil
+	}),
+}
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the config file",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		return validateConfigPaths(existingConfigPaths())
+	}),
+}
+type configMigration struct {
+	Path     string `json:"path" yaml:"path"`
+	From     int    `json:"from" yaml:"from"`
+	To       int    `json:"to" yaml:"to"`
+	Migrated bool   `json:"migrated" yaml:"migrated"`
+}
+type configMigrations []configMigration
+func (m configMigrations) Text(w io.Writer) {
+	if len(m) == 0 {
+		fmt.Fprintln(w, "No config files found")
+	}
+	for _, migration := range m {
+		if migration.Migrated {
+			fmt.Fprintf(w, "✅ %s upgraded from version %d to %d\n", migration.Path, migration.From, migration.To)
+			continue
+		}
+		fmt.Fprintf(w, "✅ %s is up to date (version %d)\n", migration.Path, migration.To)
+	}
+}
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade config files to the current schema version",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		results := configMigrations{}
+		for _, path := range existingConfigPaths() {
+			store, err := configfile.OpenStore(path)
+			if err != nil {
+				return results, output.Wrap(err, output.CodeConfig, "Fix the YAML syntax with 'dev-tools config edit'")
+			}
+			from, migrated := store.Migrated()
+			result := configMigration{Path: path, From: from, To: configfile.SchemaVersion, Migrated: migrated}
+			if migrated {
+				if err := saveConfigStore(store); err != nil {
+					return results, err
+				}
+			}
+			results = append(results, result)
+		}
+		return results, nil
+	}),
+}
+func existingConfigPaths() []string {
+	scopes := []configfile.Scope{configfile.ScopeGlobal, configfile.ScopeLocal}
+	if configScoped() {
+		scopes = []configfile.Scope{configScope()}
+	}
+	var paths []string
+	for _, scope := range scopes {
+		path, err := configfile.PathFor(scope)
+		if err != nil || containsString(paths, path) {
+			continue
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+func validateConfigPaths(paths []string) (any, error) {
+	results := configValidation{}
+	var firstErr error
+	for _, path := range paths {
+		result := configFileResult{Path: path, Valid: true}
+		store, err := configfile.OpenStore(path)
+		if err != nil {
+			result.Valid = false
+			result.Issues = []configfile.Issue{{Key: "", Message: err.Error()}}
+		} else if issues := configfile.Validate(store); len(issues) > 0 {
+			result.Valid = false
+			result.Issues = issues
+		}
+		if !result.Valid && firstErr == nil {
+			firstErr = configIssuesError(path, result.Issues)
+		}
+		results = append(results, result)
+	}
+	return results, firstErr
+}
+func configIssuesError(path string, issues []configfile.Issue) error {
+	return output.NewError(output.CodeConfig, fmt.Sprintf("%s has %d invalid entries", path, len(issues)), "Fix the listed keys with 'dev-tools config set' or 'dev-tools config edit'")
+}
+func configScoped() bool {
+	return configGlobal || configLocal
+}
+func configScope() configfile.Scope {
+	if configLocal {
+		return configfile.ScopeLocal
+	}
+	return configfile.ScopeGlobal
+}
+func configPath() (string, error) {
+	path, err := configfile.PathFor(configScope())
+	if err != nil {
+		return "", output.Wrap(err, output.CodeConfig, "Make sure $HOME is set")
+	}
+	return path, nil
+}
+func openConfigStore() (*configfile.Store, error) {
+	store, err := configfile.OpenScope(configScope())
+	if err != nil {
+		return nil, output.Wrap(err, output.CodeConfig, "Fix the YAML syntax with 'dev-tools config edit'")
+	}
+	return store, nil
+}
+func saveConfigStore(store *configfile.Store) error {
+	if err := store.Save(); err != nil {
+		return output.NewError(output.CodeCommandFailed, fmt.Sprintf("failed to write %s: %v", store.Path(), err), "")
+	}
+	return nil
+}
+func formatConfigValue(value any) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	case map[string]any:
+		out, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	return fmt.Sprint(value)
+}
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+func init() {
+	configCmd.PersistentFlags().BoolVar(&configGlobal, "global", false, "Use the global config file ($XDG_CONFIG_HOME/dev-tools/config.yaml)")
+	configCmd.PersistentFlags().BoolVar(&configLocal, "local", false, "Use the project config file (nearest .dev-tools.yaml, or ./.dev-tools.yaml when there is none)")
+	configCmd.MarkFlagsMutuallyExclusive("global", "local")
+	configGetCmd.Flags().BoolVar(&configOrigin, "show-origin", false, "Show which layer (default, user, project, env or flag) the value comes from")
+	configListCmd.Flags().BoolVar(&configOrigin, "show-origin", false, "Show which layer (default, user, project, env or flag) each value comes from")
+	configSetCmd.Flags().StringVar(&configType, "type", "", "Value type: auto, string, int, float, bool or list")
+	configCmd.AddCommand(configGetCmd, configSetCmd, configUnsetCmd, configListCmd, configEditCmd, configPathCmd, configValidateCmd, configMigrateCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,41 @@
+package cli
+import (
+	"fmt"
+	"github.com/danielscoffee/dev-tools/internal/pkg/backend/golang"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/doctor"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/spf13/cobra"
+)
+var (
+	doctorJSON bool
+)
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the environment dev-tools depends on",
+	Long:  "Diagnose the Go toolchain, go env, go-blueprint, the config file, the terminal and target directories",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		d := doctor.NewDoctor().WithBlueprint(golang.NewBlueprint().WithVersion(configfile.Current().ToolVersion("go-blueprint")))
+		if path, err := configfile.DefaultPath(); err == nil {
+			d = d.WithConfigPath(path)
+		}
+		report := d.Run(cmd.Context())
+		if report.Failed() {
+			return report, output.NewError(
+				output.CodeChecksFailed,
+				fmt.Sprintf("%d doctor checks failed", report.Count(doctor.StatusFail)),
+				"Apply the suggested fixes and run dev-tools doctor again",
+			)
+		}
+		return report, nil
+	}),
+}
+func init() {
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "Print the report as JSON (same as --output json)")
+	doctorCmd.PreRun = func(cmd *cobra.Command, args []string) {
+		if doctorJSON {
+			outputFlag = string(output.FormatJSON)
+		}
+	}
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -1,8 +1,45 @@
 package cli
 import (
 	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/backend/golang"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/danielscoffee/dev-tools/internal/pkg/recent"
 	"github.com/spf13/cobra"
 )
+var (
+	newFramework string
+	newDriver    string
+	newFeatures  []string
+	newGit       string
+	newDir       string
+	newPreset    string
+)
+type projectResult struct {
+	Name      string   `json:"name" yaml:"name"`
+	Module    string   `json:"module" yaml:"module"`
+	Framework string   `json:"framework" yaml:"framework"`
+	Driver    string   `json:"driver" yaml:"driver"`
+	Features  []string `json:"features" yaml:"features"`
+	Git       string   `json:"git" yaml:"git"`
+	Directory string   `json:"directory" yaml:"directory"`
+	Command   string   `json:"command" yaml:"command"`
+	Output    string   `json:"output,omitempty" yaml:"output,omitempty"`
+	Duration  string   `json:"duration" yaml:"duration"`
+}
+func (r projectResult) Text(w io.Writer) {
+	fmt.Fprintf(w, "📋 %s\n", r.Command)
+	if r.Output != "" {
+		fmt.Fprintln(w, strings.TrimRight(r.Output, "\n"))
+	}
+	fmt.Fprintf(w, "🎉 Project %s created in %s (%s)\n", r.Name, r.Directory, r.Duration)
+	fmt.Fprintf(w, "👀 Live reload: cd %s && dev-tools golang watch ./cmd/api\n", filepath.Join(r.Directory, path.Base(r.Module)))
+}
 var golangCmd = &cobra.Command{
 	Use:   "golang",
 	Short: "A brief description of your command",
@@ -17,7 +54,78 @@ var buildCmd = &cobra.Command{
 		fmt.Println("Building Golang project...")
 	},
 }
+var newCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Create a Go project with go-blueprint",
+	Args:  cobra.ExactArgs(1),
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		bp := golang.NewBlueprint().WithTimeout(10 * time.Minute).WithVersion(configfile.Current().ToolVersion("go-blueprint"))
+		if gobin := configfile.Current().Paths.GoBin; gobin != "" {
+			bp = bp.WithGoBin(gobin)
+		}
+		result := projectResult{Name: args[0], Module: configfile.Current().ModulePath(args[0]), Framework: newFramework, Driver: newDriver, Features: newFeatures, Git: newGit, Directory: newDir}
+		if newPreset != "" {
+			preset, ok := configfile.Current().Presets[newPreset]
+			if !ok {
+				return nil, output.NewError(output.CodeNotFound, fmt.Sprintf("preset %q is not defined", newPreset), "Define it with 'dev-tools config set presets."+newPreset+".framework <framework>'")
+			}
+			applyNewPreset(cmd, &result, preset)
+		}
+		if result.Directory == "" {
+			result.Directory = configfile.Current().Paths.Projects
+		}
+		if result.Directory == "" {
+			result.Directory = "."
+		}
+		if !containsString(bp.GetSupportedFrameworks(), result.Framework) {
+			return nil, output.NewError(output.CodeInvalidArgument, fmt.Sprintf("unsupported framework %q", result.Framework), "Use one of: "+strings.Join(bp.GetSupportedFrameworks(), ", "))
+		}
+		if !containsString(bp.GetSupportedDrivers(), result.Driver) {
+			return nil, output.NewError(output.CodeInvalidArgument, fmt.Sprintf("unsupported driver %q", result.Driver), "Use one of: "+strings.Join(bp.GetSupportedDrivers(), ", "))
+		}
+		for _, feature := range result.Features {
+			if !containsString(bp.GetSupportedFeatures(), feature) {
+				return nil, output.NewError(output.CodeInvalidArgument, fmt.Sprintf("unsupported feature %q", feature), "Use any of: "+strings.Join(bp.GetSupportedFeatures(), ", "))
+			}
+		}
+		if !bp.IsInstalled() {
+			return nil, output.NewError(output.CodeDependencyMissing, "go-blueprint is not installed or not on PATH", "Run 'go install "+bp.InstallTarget()+"' and 'dev-tools doctor'")
+		}
+		bp = bp.WithWorkingDir(result.Directory)
+		commandArgs := bp.BuildCommand(result.Module, result.Framework, result.Driver, result.Git, result.Features)
+		result.Command = "go-blueprint " + strings.Join(commandArgs, " ")
+		commandResult := bp.ExecuteCommand(cmd.Context(), commandArgs...)
+		result.Output = commandResult.Output()
+		result.Duration = commandResult.Duration.Round(time.Millisecond).String()
+		if commandResult.Failed() {
+			return result, output.NewError(output.CodeCommandFailed, fmt.Sprintf("go-blueprint exited with code %d", commandResult.ExitCode), "Check the command output above")
+		}
+		recent.Add(filepath.Join(result.Directory, path.Base(result.Module)))
+		return result, nil
+	}),
+}
+func applyNewPreset(cmd *cobra.Command, result *projectResult, preset configfile.Preset) {
+	if preset.Framework != "" && !cmd.Flags().Changed("framework") {
+		result.Framework = preset.Framework
+	}
+	if preset.Driver != "" && !cmd.Flags().Changed("driver") {
+		result.Driver = preset.Driver
+	}
+	if len(preset.Features) > 0 && !cmd.Flags().Changed("feature") {
+		result.Features = preset.Features
+	}
+	if preset.Git != "" && !cmd.Flags().Changed("git") {
+		result.Git = preset.Git
+	}
+}
 func init() {
+	newCmd.Flags().StringVar(&newFramework, "framework", "gin", "Web framework")
+	newCmd.Flags().StringVar(&newDriver, "driver", "none", "Database driver")
+	newCmd.Flags().StringSliceVar(&newFeatures, "feature", nil, "Advanced feature to include (repeatable)")
+	newCmd.Flags().StringVar(&newGit, "git", "commit", "Git option: init, commit or skip")
+	newCmd.Flags().StringVar(&newDir, "dir", "", "Directory to create the project in (defaults to paths.projects)")
+	newCmd.Flags().StringVar(&newPreset, "preset", "", "Apply a preset from the config file")
 	rootCmd.AddCommand(golangCmd)
 	golangCmd.AddCommand(buildCmd)
+	golangCmd.AddCommand(newCmd)
 }
@@ -0,0 +1,67 @@
+package cli
+import (
+	"errors"
+	"os"
+	"strings"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/spf13/cobra"
+)
+var (
+	outputFlag string
+)
+type renderedError struct {
+	err error
+}
+func (e renderedError) Error() string {
+	return e.err.Error()
+}
+func (e renderedError) Unwrap() error {
+	return e.err
+}
+type resultFunc func(cmd *cobra.Command, args []string) (any, error)
+func outputRenderer() *output.Renderer {
+	format, err := output.ParseFormat(outputFlag)
+	if err != nil {
+		format = output.FormatText
+	}
+	return output.NewRenderer(format, os.Stdout, os.Stderr)
+}
+func commandName(cmd *cobra.Command) string {
+	if cmd == nil || cmd == rootCmd {
+		return rootCmd.Name()
+	}
+	return strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+}
+func withOutput(fn resultFunc) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if _, err := output.ParseFormat(outputFlag); err != nil {
+			return err
+		}
+		data, err := fn(cmd, args)
+		if data == nil && err != nil {
+			return err
+		}
+		if renderErr := outputRenderer().Render(commandName(cmd), data, err); renderErr != nil {
+			return renderErr
+		}
+		if err != nil {
+			return renderedError{err: err}
+		}
+		return nil
+	}
+}
+func renderExecuteError(cmd *cobra.Command, err error) int {
+	var rendered renderedError
+	if errors.As(err, &rendered) {
+		return output.AsError(err).ExitCode
+	}
+	var outErr *output.Error
+	if !errors.As(err, &outErr) {
+		outErr = output.Wrap(err, output.CodeUsage, "Run '"+cmd.CommandPath()+" --help' for usage").WithExitCode(2)
+	}
+	outputRenderer().Render(commandName(cmd), nil, outErr)
+	return outErr.ExitCode
+}
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&outputFlag, "output", "o", "text", "Output format: text, json or yaml")
+}
@@ -0,0 +1,45 @@
+package cli
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/danielscoffee/dev-tools/internal/pkg/plugin"
+	"github.com/spf13/cobra"
+)
+const pluginGroupID = "plugins"
+func registerPlugins() {
+	plugins := plugin.Discover(plugin.DefaultDirs()...)
+	if len(plugins) == 0 {
+		return
+	}
+	rootCmd.AddGroup(&cobra.Group{ID: pluginGroupID, Title: "Plugin Commands:"})
+	for _, p := range plugins {
+		if p.Name == "help" || p.Name == "completion" {
+			continue
+		}
+		if cmd, _, err := rootCmd.Find([]string{p.Name}); err == nil && cmd != rootCmd {
+			continue
+		}
+		rootCmd.AddCommand(newPluginCmd(p))
+	}
+}
+func newPluginCmd(p plugin.Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                p.Name,
+		Short:              fmt.Sprintf("Run the %s plugin (%s)", p.Name, p.Path),
+		GroupID:            pluginGroupID,
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := p.Run(cmd.Context(), args...)
+			if err == nil {
+				return nil
+			}
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				return output.NewError(output.CodeCommandFailed, fmt.Sprintf("plugin %s exited with code %d", p.Name, exitErr.ExitCode()), "").WithExitCode(exitErr.ExitCode())
+			}
+			return output.NewError(output.CodeCommandFailed, fmt.Sprintf("failed to run plugin %s: %v", p.Name, err), "Check that "+p.Path+" is executable")
+		},
+	}
+}
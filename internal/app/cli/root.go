@@ -1,21 +1,55 @@
 package cli
 import (
+	"fmt"
 	"os"
+	"strings"
 	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
 	"github.com/spf13/cobra"
 )
 type CLI struct{}
+var profileFlag string
 var rootCmd = &cobra.Command{
-	Use:   "dev-tools",
-	Short: "Compilation of tools that give a AWESOME developer experience",
+	Use:           "dev-tools",
+	Short:         "Compilation of tools that give a AWESOME developer experience",
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := configfile.LoadError(); err != nil {
+			if !repairsConfig(cmd) {
+				return output.Wrap(err, output.CodeConfig, "Fix the config file, see 'dev-tools config validate' or 'dev-tools doctor'")
+			}
+			fmt.Fprintf(os.Stderr, "⚠️  %v\n", err)
+		}
+		if _, err := configfile.ActiveProfile(); err != nil {
+			hint := "Define it under profiles in the config file"
+			if names := configfile.Current().ProfileNames(); len(names) > 0 {
+				hint = "Use one of: " + strings.Join(names, ", ")
+			}
+			return output.Wrap(err, output.CodeConfig, hint)
+		}
+		return configfile.Current().ApplyEnv()
+	},
+}
+func repairsConfig(cmd *cobra.Command) bool {
+	for ; cmd != nil; cmd = cmd.Parent() {
+		switch cmd.Name() {
+		case "config", "doctor", "help", "completion":
+			return true
+		}
+	}
+	return false
 }
 func (c CLI) Execute() {
-	err := rootCmd.Execute()
+	registerPlugins()
+	cmd, err := rootCmd.ExecuteC()
 	if err != nil {
-		os.Exit(1)
+		os.Exit(renderExecuteError(cmd, err))
 	}
 }
 func init() {
 	cf := &configfile.ConfigFile{}
 	cobra.OnInitialize(cf.InitConfig)
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "Config profile to apply (default: the profile key or $DEV_TOOLS_PROFILE)")
+	configfile.BindFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
 }
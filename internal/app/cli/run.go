@@ -0,0 +1,118 @@
+package cli
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/danielscoffee/dev-tools/internal/pkg/tasks"
+	"github.com/spf13/cobra"
+)
+var (
+	runParallel int
+	runForce    bool
+)
+type taskList struct {
+	File  string        `json:"file" yaml:"file"`
+	Tasks []*tasks.Task `json:"tasks" yaml:"tasks"`
+}
+func (l taskList) Text(w io.Writer) {
+	if len(l.Tasks) == 0 {
+		fmt.Fprintf(w, "No tasks defined in %s\n", l.File)
+		return
+	}
+	width := 0
+	for _, task := range l.Tasks {
+		width = max(width, len(task.Name))
+	}
+	for _, task := range l.Tasks {
+		line := fmt.Sprintf("%-*s  %s", width, task.Name, task.Description)
+		if len(task.Deps) > 0 {
+			line += fmt.Sprintf(" (deps: %s)", strings.Join(task.Deps, ", "))
+		}
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+}
+type runResult []tasks.Result
+func (r runResult) Text(w io.Writer) {
+	for _, result := range r {
+		switch result.Status {
+		case tasks.StatusOK:
+			fmt.Fprintf(w, "✅ %s (%s)\n", result.Task, result.Duration)
+		case tasks.StatusUpToDate:
+			fmt.Fprintf(w, "⏭️  %s is up to date\n", result.Task)
+		case tasks.StatusSkipped:
+			fmt.Fprintf(w, "⏭️  %s skipped: %s\n", result.Task, result.Error)
+		default:
+			fmt.Fprintf(w, "❌ %s (%s): %s\n", result.Task, result.Duration, result.Error)
+		}
+	}
+}
+var runCmd = &cobra.Command{
+	Use:   "run [task...]",
+	Short: "Run tasks defined in the project's .dev-tools.yaml",
+	Long:  "Run tasks from the tasks section of the project's .dev-tools.yaml in dependency order. Without arguments the available tasks are listed.",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		file, err := loadTasks()
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 0 {
+			list := taskList{File: file.Path}
+			for _, name := range file.Names() {
+				list.Tasks = append(list.Tasks, file.Tasks[name])
+			}
+			return list, nil
+		}
+		runner := tasks.NewRunner(file).WithParallel(runParallel).WithForce(runForce)
+		if !outputRenderer().Structured() {
+			runner = runner.WithHandler(printTaskEvent(os.Stdout))
+		}
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+		results, err := runner.Run(ctx, args...)
+		if errors.Is(err, tasks.ErrUnknownTask) {
+			return nil, output.Wrap(err, output.CodeNotFound, "Run 'dev-tools run' to list the tasks in "+file.Path)
+		}
+		if err != nil && results == nil {
+			return nil, output.Wrap(err, output.CodeConfig, "Fix the tasks section in "+file.Path)
+		}
+		if err != nil {
+			return runResult(results), output.Wrap(err, output.CodeCommandFailed, "")
+		}
+		return runResult(results), nil
+	}),
+}
+func loadTasks() (*tasks.File, error) {
+	path, err := configfile.LocalPath()
+	if err != nil {
+		return nil, output.Wrap(err, output.CodeConfig, "")
+	}
+	file, err := tasks.Load(path)
+	if errors.Is(err, tasks.ErrUnknownTask) {
+		return nil, output.Wrap(err, output.CodeConfig, "Fix the deps in "+path)
+	}
+	if err != nil {
+		return nil, output.Wrap(err, output.CodeConfig, "Fix the tasks section in "+path)
+	}
+	return file, nil
+}
+func printTaskEvent(w io.Writer) func(tasks.Event) {
+	return func(event tasks.Event) {
+		switch event.Type {
+		case tasks.EventStart:
+			fmt.Fprintf(w, "▶️  %s\n", event.Task)
+		case tasks.EventOutput:
+			fmt.Fprintf(w, "[%s] %s\n", event.Task, event.Line)
+		}
+	}
+}
+func init() {
+	runCmd.Flags().IntVarP(&runParallel, "parallel", "j", runtime.NumCPU(), "Maximum number of tasks to run at once")
+	runCmd.Flags().BoolVarP(&runForce, "force", "f", false, "Run tasks even when their outputs are up to date")
+	rootCmd.AddCommand(runCmd)
+}
@@ -1,33 +1,61 @@
 package cli
 import (
+	"errors"
 	"fmt"
-	"log"
-	"os"
+	"net/url"
+	"strings"
 	"github.com/danielscoffee/dev-tools/internal/app/tui"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
 	"github.com/spf13/cobra"
 )
 var (
 	themeFlag string
+	routeFlag string
+	setFlags  []string
 )
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
 	Short: "Start a SUPER AWESOME TUI mode",
 	Long:  "Launch the Terminal User Interface for an interactive development tools experience",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("🚀 Starting Dev Tools TUI...")
-		selectedTheme := themeFlag
-		if selectedTheme == "" {
-			selectedTheme = os.Getenv("DEV_TOOLS_THEME")
+	Example: "  dev-tools tui --route /langs/golang/blueprint --set framework=gin --set driver=postgres",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		route, err := startRoute(routeFlag, setFlags)
+		if err != nil {
+			return output.NewError(output.CodeInvalidArgument, err.Error(), "Pass parameters as --route /path --set key=value")
 		}
-		if selectedTheme == "" {
-			selectedTheme = "dark"
+		fmt.Println("🚀 Starting Dev Tools TUI...")
+		err = tui.InitializeAt(configfile.Current().Theme, route)
+		if errors.Is(err, tui.ErrStartRoute) {
+			return output.Wrap(err, output.CodeInvalidArgument, "Check the --route path and its --set parameters")
 		}
-		if err := tui.InitializeWithTheme(selectedTheme); err != nil {
-			log.Fatalf("Failed to start TUI: %v", err)
+		if err != nil {
+			return output.Wrap(err, output.CodeCommandFailed, "")
 		}
+		return nil
 	},
 }
+func startRoute(route string, sets []string) (string, error) {
+	if len(sets) == 0 {
+		return route, nil
+	}
+	if route == "" {
+		return "", fmt.Errorf("--set needs --route")
+	}
+	params := url.Values{}
+	for _, set := range sets {
+		key, value, ok := strings.Cut(set, "=")
+		if !ok || key == "" {
+			return "", fmt.Errorf("invalid --set %q, expected key=value", set)
+		}
+		params.Add(key, value)
+	}
+	return route + "?" + params.Encode(), nil
+}
 func init() {
 	tuiCmd.Flags().StringVarP(&themeFlag, "theme", "t", "", "Set theme (dark/light)")
+	configfile.BindFlag("theme", tuiCmd.Flags().Lookup("theme"))
+	tuiCmd.Flags().StringVar(&routeFlag, "route", "", "Open the TUI on this route, e.g. /langs/golang/blueprint")
+	tuiCmd.Flags().StringArrayVar(&setFlags, "set", nil, "Pass a key=value parameter to the --route page (repeatable)")
 	rootCmd.AddCommand(tuiCmd)
 }
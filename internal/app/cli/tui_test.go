@@ -0,0 +1,46 @@
+package cli
+import (
+	"testing"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+)
+func TestStartRoute(t *testing.T) {
+	tests := []struct {
+		route   string
+		sets    []string
+		want    string
+		wantErr bool
+	}{
+		{route: "/config", want: "/config"},
+		{route: "", want: ""},
+		{route: "/langs/golang/blueprint", sets: []string{"framework=gin", "name=my api"}, want: "/langs/golang/blueprint?framework=gin&name=my+api"},
+		{route: "/langs/golang/blueprint", sets: []string{"features=htmx", "features=docker"}, want: "/langs/golang/blueprint?features=htmx&features=docker"},
+		{route: "/langs/golang/blueprint", sets: []string{"driver=a=b"}, want: "/langs/golang/blueprint?driver=a%3Db"},
+		{sets: []string{"framework=gin"}, wantErr: true},
+		{route: "/langs/golang/blueprint", sets: []string{"framework"}, wantErr: true},
+		{route: "/langs/golang/blueprint", sets: []string{"=gin"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := startRoute(tt.route, tt.sets)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("startRoute(%q, %q) = (%q, %v), want %q", tt.route, tt.sets, got, err, tt.want)
+		}
+	}
+}
+func TestTuiCommandRejectsBadArguments(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Cleanup(func() { routeFlag, setFlags = "", nil })
+	for _, args := range []struct {
+		route string
+		sets  []string
+	}{
+		{sets: []string{"framework=gin"}},
+		{route: "/langs/golang/blueprint", sets: []string{"=gin"}},
+		{route: "/missing"},
+	} {
+		routeFlag, setFlags = args.route, args.sets
+		err := tuiCmd.RunE(tuiCmd, nil)
+		if err == nil || output.AsError(err).Code != output.CodeInvalidArgument {
+			t.Errorf("tui --route %q --set %q error = %v, want %s", args.route, args.sets, err, output.CodeInvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cli
+import (
+	"errors"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/tasks"
+	"github.com/danielscoffee/dev-tools/internal/pkg/watch"
+	"github.com/go-viper/mapstructure/v2"
+)
+func validateTasksConfig(value any) []configfile.Issue {
+	var issues []configfile.Issue
+	raw, _ := value.(map[string]any)
+	defs := make(map[string]*tasks.Task, len(raw))
+	for name, def := range raw {
+		var task tasks.Task
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			Result:      &task,
+			ErrorUnused: true,
+		})
+		if err == nil {
+			err = decoder.Decode(def)
+		}
+		if err != nil {
+			issues = append(issues, configfile.Issue{Key: "tasks." + name, Message: configfile.DecodeMessage(err)})
+		}
+		defs[name] = &task
+	}
+	for _, err := range tasks.New("", defs).Check() {
+		var taskErr *tasks.TaskError
+		if !errors.As(err, &taskErr) {
+			issues = append(issues, configfile.Issue{Key: "tasks", Message: err.Error()})
+			continue
+		}
+		key := "tasks." + taskErr.Task
+		if taskErr.Field != "" {
+			key += "." + taskErr.Field
+		}
+		issues = append(issues, configfile.Issue{Key: key, Message: taskErr.Err.Error()})
+	}
+	return issues
+}
+func validateWatchConfig(value any) []configfile.Issue {
+	var cfg watch.Config
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:      &cfg,
+		ErrorUnused: true,
+		DecodeHook:  mapstructure.StringToTimeDurationHookFunc(),
+	})
+	if err == nil {
+		err = decoder.Decode(value)
+	}
+	if err != nil {
+		return []configfile.Issue{{Key: "watch", Message: configfile.DecodeMessage(err)}}
+	}
+	return nil
+}
+func init() {
+	configfile.RegisterValidator("tasks", validateTasksConfig)
+	configfile.RegisterValidator("watch", validateWatchConfig)
+}
@@ -0,0 +1,46 @@
+package cli
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/tasks"
+)
+func TestConfigValidatorsMatchTaskLoading(t *testing.T) {
+	tests := []struct {
+		content string
+		want    []string
+		loadErr bool
+	}{
+		{content: "tasks:\n  build:\n    cmds: [go build]\n"},
+		{content: "tasks:\n  Build:\n    cmds: [go build]\n  all:\n    deps: [BUILD]\n"},
+		{content: "tasks:\n  build:\n    cmds: [go build]\n    deps: [lint]\n", want: []string{"tasks.build.deps"}, loadErr: true},
+		{content: "tasks:\n  empty: {}\n", want: []string{"tasks.empty"}, loadErr: true},
+		{content: "tasks:\n  a:\n    deps: [b]\n  b:\n    deps: [a]\n", want: []string{"tasks"}, loadErr: true},
+		{content: "tasks:\n  build:\n    cmds: [go build]\n    needs: [lint]\n", want: []string{"tasks.build"}},
+		{content: "watch:\n  debounce: soon\n", want: []string{"watch"}},
+		{content: "watch:\n  debounce: 300ms\n  env_file: .env\n"},
+	}
+	dir := t.TempDir()
+	for _, tt := range tests {
+		path := filepath.Join(dir, configfile.FileName)
+		if err := os.WriteFile(path, []byte("version: 2\n"+tt.content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		store, err := configfile.OpenStore(path)
+		if err != nil {
+			t.Fatalf("OpenStore() error = %v", err)
+		}
+		var got []string
+		for _, issue := range configfile.Validate(store) {
+			got = append(got, issue.Key)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Validate(%q) keys = %v, want %v", tt.content, got, tt.want)
+		}
+		if _, err := tasks.Load(path); (err != nil) != tt.loadErr {
+			t.Errorf("tasks.Load(%q) error = %v, want error %v", tt.content, err, tt.loadErr)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package cli
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/output"
+	"github.com/danielscoffee/dev-tools/internal/pkg/watch"
+	"github.com/spf13/cobra"
+)
+var (
+	watchDir      string
+	watchBin      string
+	watchInclude  []string
+	watchExclude  []string
+	watchPre      []string
+	watchPost     []string
+	watchEnvFile  string
+	watchDebounce time.Duration
+)
+type watchSummary struct {
+	Dir      string `json:"dir" yaml:"dir"`
+	Bin      string `json:"bin" yaml:"bin"`
+	Builds   int    `json:"builds" yaml:"builds"`
+	Failures int    `json:"failures" yaml:"failures"`
+	Restarts int    `json:"restarts" yaml:"restarts"`
+}
+func (s *watchSummary) Text(w io.Writer) {
+	fmt.Fprintf(w, "👋 Stopped watching %s (%d builds, %d failed, %d restarts)\n", s.Dir, s.Builds, s.Failures, s.Restarts)
+}
+func (s *watchSummary) handle(w io.Writer) func(watch.Event) {
+	return func(event watch.Event) {
+		switch event.Type {
+		case watch.EventChange:
+			fmt.Fprintf(w, "🔄 Changed: %s\n", strings.Join(event.Files, ", "))
+		case watch.EventBuild:
+			s.Builds++
+			fmt.Fprintln(w, "🔨 Building...")
+		case watch.EventBuildFailed:
+			s.Failures++
+			fmt.Fprintln(w, "❌ Build failed:")
+			if event.Output != "" {
+				fmt.Fprintln(w, strings.TrimRight(event.Output, "\n"))
+			} else if event.Err != nil {
+				fmt.Fprintln(w, event.Err)
+			}
+		case watch.EventStart:
+			s.Restarts++
+			fmt.Fprintf(w, "🚀 Started %s (pid %d)\n", s.Bin, event.PID)
+		case watch.EventStop:
+			fmt.Fprintf(w, "🛑 Stopped pid %d\n", event.PID)
+		case watch.EventExit:
+			fmt.Fprintf(w, "⚠️  Process %d exited: %v\n", event.PID, event.Err)
+		case watch.EventOutput:
+			fmt.Fprintln(w, event.Line)
+		case watch.EventError:
+			fmt.Fprintf(w, "❌ %v\n", event.Err)
+		}
+	}
+}
+var watchCmd = &cobra.Command{
+	Use:   "watch [package] [-- args...]",
+	Short: "Rebuild and restart a Go service when its files change",
+	Long:  "Watch a Go module, rebuild on change and gracefully restart the service binary. Defaults come from the watch section of .dev-tools.yaml.",
+	RunE: withOutput(func(cmd *cobra.Command, args []string) (any, error) {
+		cfg := configfile.Current().Watch
+		dash := cmd.ArgsLenAtDash()
+		if dash >= 0 {
+			cfg.Args = args[dash:]
+			args = args[:dash]
+		}
+		if len(args) > 1 {
+			return nil, output.NewError(output.CodeInvalidArgument, "watch takes at most one package", "Pass service arguments after --")
+		}
+		if len(args) == 1 {
+			cfg.Package = args[0]
+		}
+		flags := cmd.Flags()
+		if flags.Changed("dir") {
+			cfg.Dir = watchDir
+		}
+		if flags.Changed("bin") {
+			cfg.Bin = watchBin
+		}
+		if flags.Changed("include") {
+			cfg.Include = watchInclude
+		}
+		if flags.Changed("exclude") {
+			cfg.Exclude = watchExclude
+		}
+		if flags.Changed("pre") {
+			cfg.Pre = watchPre
+		}
+		if flags.Changed("post") {
+			cfg.Post = watchPost
+		}
+		if flags.Changed("env-file") {
+			cfg.EnvFile = watchEnvFile
+		}
+		if flags.Changed("debounce") {
+			cfg.Debounce = watchDebounce
+		}
+		watcher := watch.New(cfg)
+		cfg = watcher.Config()
+		if _, err := os.Stat(cfg.Dir); err != nil {
+			return nil, output.NewError(output.CodeNotFound, fmt.Sprintf("directory %s does not exist", cfg.Dir), "")
+		}
+		summary := &watchSummary{Dir: cfg.Dir, Bin: cfg.Bin}
+		events := io.Writer(os.Stdout)
+		if outputRenderer().Structured() {
+			events = os.Stderr
+		}
+		fmt.Fprintf(events, "👀 Watching %s (ctrl+c to stop)\n", cfg.Dir)
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		if err := watcher.WithHandler(summary.handle(events)).Run(ctx); err != nil {
+			return summary, output.Wrap(err, output.CodeCommandFailed, "")
+		}
+		return summary, nil
+	}),
+}
+func init() {
+	watchCmd.Flags().StringVar(&watchDir, "dir", "", "Module directory to watch (default: current directory)")
+	watchCmd.Flags().StringVar(&watchBin, "bin", "", "Path of the built binary (default: a temporary file)")
+	watchCmd.Flags().StringSliceVar(&watchInclude, "include", nil, "Glob of files that trigger a rebuild (repeatable, default: "+strings.Join(watch.DefaultInclude, ",")+")")
+	watchCmd.Flags().StringSliceVar(&watchExclude, "exclude", nil, "Glob of files and directories to ignore (repeatable, default: "+strings.Join(watch.DefaultExclude, ",")+")")
+	watchCmd.Flags().StringArrayVar(&watchPre, "pre", nil, "Shell command to run before each build (repeatable)")
+	watchCmd.Flags().StringArrayVar(&watchPost, "post", nil, "Shell command to run after each restart (repeatable)")
+	watchCmd.Flags().StringVar(&watchEnvFile, "env-file", "", "Env file loaded into the build and the service, e.g. .env")
+	watchCmd.Flags().DurationVar(&watchDebounce, "debounce", 300*time.Millisecond, "Quiet period after the last change before rebuilding")
+	golangCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,49 @@
+package tui
+import (
+	"strings"
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+)
+type busyPage struct {
+	stubPage
+	busy string
+}
+func (p *busyPage) Busy() string {
+	return p.busy
+}
+func (p *busyPage) Cancel() tea.Cmd {
+	p.busy = ""
+	return jobs.Cancel(7)
+}
+func TestCancelBusy(t *testing.T) {
+	page := &busyPage{stubPage: stubPage{title: "Tasks"}, busy: "task build"}
+	r := NewRouter()
+	r.RegisterRoute("/", page, "Tasks", "", "h")
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyEsc}, {Type: tea.KeyCtrlC}} {
+		handled, cmd := r.HandleInput(key)
+		if !handled || cmd == nil {
+			t.Fatalf("HandleInput(%s) while busy = (%v, %v), want a confirmation", key, handled, cmd)
+		}
+		if modal, ok := cmd().(overlay.ModalMsg); !ok || modal.ID != cancelModal || modal.Title != "Cancel task build?" {
+			t.Errorf("HandleInput(%s) = %#v, want the cancel confirmation", key, modal)
+		}
+	}
+	if footer := r.RenderFooter(120); !strings.Contains(footer, "Cancel task build") {
+		t.Errorf("footer = %q, want the cancel hint", footer)
+	}
+	cmd := r.CancelBusy()
+	if cmd == nil || page.busy != "" {
+		t.Fatal("CancelBusy() should cancel the page's work")
+	}
+	if msg, ok := cmd().(jobs.CancelMsg); !ok || msg.ID != 7 {
+		t.Errorf("CancelBusy() = %#v, want jobs.CancelMsg{ID: 7}", msg)
+	}
+	if r.CancelBusy() != nil {
+		t.Error("CancelBusy() on an idle page should do nothing")
+	}
+	if _, cmd := r.HandleInput(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil || cmd() != tea.Quit() {
+		t.Error("ctrl+c on an idle page should quit")
+	}
+}
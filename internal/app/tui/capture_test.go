@@ -0,0 +1,50 @@
+package tui
+import (
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+)
+type inputPage struct {
+	stubPage
+	editing bool
+}
+func (p *inputPage) CapturesInput() bool {
+	return p.editing
+}
+func (p *inputPage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if msg.String() == "esc" && p.editing {
+		p.editing = false
+		return true, nil
+	}
+	return p.stubPage.HandleInput(msg)
+}
+func TestCapturingSuspendsShortcuts(t *testing.T) {
+	page := &inputPage{stubPage: stubPage{title: "Paths"}, editing: true}
+	r := NewRouter()
+	r.RegisterRoute("/", &stubPage{title: "Home"}, "Home", "", "h")
+	configRoute, _ := r.RegisterRoute("/config", page, "Config", "", "c")
+	configRoute.WithScope(ScopeGlobal)
+	langsRoute, _ := r.RegisterRoute("/langs", &stubPage{title: "Languages"}, "Languages", "", "l")
+	langsRoute.WithScope(ScopeGlobal)
+	r.NavigateTo("/config")
+	for _, key := range []string{"q", "l"} {
+		if handled, cmd := r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}); !handled || cmd != nil {
+			t.Errorf("HandleInput(%s) while capturing = (%v, %v), want it typed into the page", key, handled, cmd)
+		}
+	}
+	if len(page.keys) != 2 || r.GetCurrentRoute().Path != "/config" {
+		t.Fatalf("page keys = %v on %s, want [q l] on /config", page.keys, r.GetCurrentRoute().Path)
+	}
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyEsc})
+	if page.editing || r.GetCurrentRoute().Path != "/config" {
+		t.Fatal("the first esc should end editing without leaving the page")
+	}
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	if r.GetCurrentRoute().Path != "/langs" {
+		t.Errorf("route keys should work again once capturing ends, on %s", r.GetCurrentRoute().Path)
+	}
+	page.editing = true
+	r.NavigateTo("/config")
+	if handled, cmd := r.HandleInput(tea.KeyMsg{Type: tea.KeyCtrlC}); handled || cmd == nil {
+		t.Error("ctrl+c should still quit while capturing")
+	}
+}
@@ -0,0 +1,230 @@
+// Package jobs
+package jobs
+import (
+	"context"
+	"sync"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+)
+const maxLogLines = 1000
+type Status string
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCanceled  Status = "canceled"
+)
+type Func func(ctx context.Context, job *Job) error
+type EventMsg struct {
+	Msg tea.Msg
+}
+type StartMsg struct {
+	Owner string
+	Title string
+	Run   Func
+}
+type StartedMsg struct {
+	ID    int
+	Owner string
+}
+type LogMsg struct {
+	ID    int
+	Owner string
+	Line  string
+}
+type DoneMsg struct {
+	ID     int
+	Owner  string
+	Title  string
+	Status Status
+	Err    error
+}
+type CancelMsg struct {
+	ID int
+}
+type Job struct {
+	ID       int
+	Owner    string
+	Title    string
+	Started  time.Time
+	finished time.Time
+	manager  *Manager
+	run      Func
+	cancel   context.CancelFunc
+	status   Status
+	err      error
+	log      []string
+}
+type Manager struct {
+	mu        sync.Mutex
+	jobs      []*Job
+	next      int
+	events    chan tea.Msg
+	wg        sync.WaitGroup
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+func NewManager() *Manager {
+	return &Manager{
+		events: make(chan tea.Msg, 256),
+		closed: make(chan struct{}),
+	}
+}
+func Run(owner, title string, run Func) tea.Cmd {
+	return func() tea.Msg {
+		return StartMsg{Owner: owner, Title: title, Run: run}
+	}
+}
+func Cancel(id int) tea.Cmd {
+	return func() tea.Msg {
+		return CancelMsg{ID: id}
+	}
+}
+func (m *Manager) Start(owner, title string, run Func) *Job {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.mu.Lock()
+	m.next++
+	job := &Job{
+		ID:      m.next,
+		Owner:   owner,
+		Title:   title,
+		Started: time.Now(),
+		manager: m,
+		run:     run,
+		cancel:  cancel,
+		status:  StatusRunning,
+	}
+	m.jobs = append(m.jobs, job)
+	m.mu.Unlock()
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		err := run(ctx, job)
+		m.mu.Lock()
+		job.finished = time.Now()
+		job.err = err
+		switch {
+		case ctx.Err() == context.Canceled:
+			job.status = StatusCanceled
+		case err != nil:
+			job.status = StatusFailed
+		default:
+			job.status = StatusSucceeded
+		}
+		done := DoneMsg{ID: job.ID, Owner: job.Owner, Title: job.Title, Status: job.status, Err: err}
+		m.mu.Unlock()
+		cancel()
+		m.send(done)
+	}()
+	return job
+}
+func (m *Manager) send(msg tea.Msg) {
+	select {
+	case m.events <- msg:
+	case <-m.closed:
+	}
+}
+func (m *Manager) Listen() tea.Cmd {
+	return func() tea.Msg {
+		return EventMsg{Msg: <-m.events}
+	}
+}
+func (m *Manager) Get(id int) (*Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, job := range m.jobs {
+		if job.ID == id {
+			return job, true
+		}
+	}
+	return nil, false
+}
+func (m *Manager) Jobs() []*Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := make([]*Job, len(m.jobs))
+	for i, job := range m.jobs {
+		jobs[len(m.jobs)-1-i] = job
+	}
+	return jobs
+}
+func (m *Manager) Running() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	running := 0
+	for _, job := range m.jobs {
+		if job.status == StatusRunning {
+			running++
+		}
+	}
+	return running
+}
+func (m *Manager) Cancel(id int) bool {
+	job, ok := m.Get(id)
+	if !ok || job.Status() != StatusRunning {
+		return false
+	}
+	job.cancel()
+	return true
+}
+func (m *Manager) Retry(id int) (*Job, bool) {
+	job, ok := m.Get(id)
+	if !ok || job.Status() == StatusRunning {
+		return nil, false
+	}
+	return m.Start(job.Owner, job.Title, job.run), true
+}
+func (m *Manager) CancelAll(timeout time.Duration) bool {
+	m.closeOnce.Do(func() { close(m.closed) })
+	m.mu.Lock()
+	for _, job := range m.jobs {
+		job.cancel()
+	}
+	m.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+func (j *Job) Log(line string) {
+	j.manager.mu.Lock()
+	j.log = append(j.log, line)
+	if len(j.log) > maxLogLines {
+		j.log = j.log[len(j.log)-maxLogLines:]
+	}
+	j.manager.mu.Unlock()
+	j.manager.send(LogMsg{ID: j.ID, Owner: j.Owner, Line: line})
+}
+func (j *Job) Send(msg tea.Msg) {
+	j.manager.send(msg)
+}
+func (j *Job) Lines() []string {
+	j.manager.mu.Lock()
+	defer j.manager.mu.Unlock()
+	return append([]string(nil), j.log...)
+}
+func (j *Job) Status() Status {
+	j.manager.mu.Lock()
+	defer j.manager.mu.Unlock()
+	return j.status
+}
+func (j *Job) Err() error {
+	j.manager.mu.Lock()
+	defer j.manager.mu.Unlock()
+	return j.err
+}
+func (j *Job) Duration() time.Duration {
+	j.manager.mu.Lock()
+	defer j.manager.mu.Unlock()
+	if j.finished.IsZero() {
+		return time.Since(j.Started)
+	}
+	return j.finished.Sub(j.Started)
+}
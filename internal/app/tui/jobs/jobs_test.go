@@ -0,0 +1,120 @@
+package jobs
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+)
+func receive(t *testing.T, m *Manager) tea.Msg {
+	t.Helper()
+	done := make(chan tea.Msg, 1)
+	go func() { done <- m.Listen()().(EventMsg).Msg }()
+	select {
+	case msg := <-done:
+		return msg
+	case <-time.After(2 * time.Second):
+		t.Fatal("no job event")
+		return nil
+	}
+}
+func TestStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name   string
+		run    Func
+		cancel bool
+		want   Status
+	}{
+		{name: "succeeds", run: func(ctx context.Context, job *Job) error { return nil }, want: StatusSucceeded},
+		{name: "fails", run: func(ctx context.Context, job *Job) error { return errors.New("exit status 1") }, want: StatusFailed},
+		{name: "canceled", run: func(ctx context.Context, job *Job) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, cancel: true, want: StatusCanceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager()
+			job := m.Start("tasks", tt.name, tt.run)
+			if tt.cancel {
+				if m.Running() != 1 || job.Status() != StatusRunning {
+					t.Fatalf("job should be running before Cancel, got %s", job.Status())
+				}
+				if !m.Cancel(job.ID) {
+					t.Fatal("Cancel() = false for a running job")
+				}
+			}
+			done, ok := receive(t, m).(DoneMsg)
+			if !ok || done.ID != job.ID || done.Owner != "tasks" || done.Status != tt.want {
+				t.Fatalf("event = %#v, want a DoneMsg with %s", done, tt.want)
+			}
+			if job.Status() != tt.want || m.Running() != 0 {
+				t.Errorf("Status() = %s with %d running", job.Status(), m.Running())
+			}
+			if m.Cancel(job.ID) {
+				t.Error("Cancel() = true for a finished job")
+			}
+		})
+	}
+}
+func TestRetry(t *testing.T) {
+	m := NewManager()
+	release := make(chan struct{})
+	runs := 0
+	job := m.Start("blueprint", "Create api", func(ctx context.Context, job *Job) error {
+		runs++
+		job.Log("creating")
+		<-release
+		return nil
+	})
+	if _, ok := m.Retry(job.ID); ok {
+		t.Fatal("Retry() should refuse a running job")
+	}
+	if log, ok := receive(t, m).(LogMsg); !ok || log.Line != "creating" || log.ID != job.ID {
+		t.Errorf("event = %#v, want the log line", log)
+	}
+	close(release)
+	receive(t, m)
+	retried, ok := m.Retry(job.ID)
+	if !ok || retried.ID == job.ID || retried.Title != "Create api" {
+		t.Fatalf("Retry() = (%v, %v), want a new job with the same title", retried, ok)
+	}
+	receive(t, m)
+	receive(t, m)
+	if runs != 2 || len(m.Jobs()) != 2 || m.Jobs()[0] != retried {
+		t.Errorf("runs = %d, Jobs() = %v, want the retry listed first", runs, m.Jobs())
+	}
+	if _, ok := m.Retry(99); ok {
+		t.Error("Retry() should refuse an unknown job")
+	}
+	if lines := job.Lines(); len(lines) != 1 || lines[0] != "creating" {
+		t.Errorf("Lines() = %v", lines)
+	}
+}
+func TestCancelAll(t *testing.T) {
+	m := NewManager()
+	for i := 0; i < 300; i++ {
+		m.Start("tasks", "chatty", func(ctx context.Context, job *Job) error {
+			job.Log("line")
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}
+	if !m.CancelAll(2 * time.Second) {
+		t.Fatal("CancelAll() timed out although every job stops on cancel")
+	}
+	stuck := NewManager()
+	release := make(chan struct{})
+	defer close(release)
+	stuck.Start("tasks", "stuck", func(ctx context.Context, job *Job) error {
+		<-release
+		return nil
+	})
+	start := time.Now()
+	if stuck.CancelAll(50 * time.Millisecond) {
+		t.Error("CancelAll() = true for a job that ignores cancellation")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("CancelAll() waited %s, want it to give up after the timeout", elapsed)
+	}
+}
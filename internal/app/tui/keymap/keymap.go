@@ -0,0 +1,180 @@
+// Package keymap
+package keymap
+import (
+	"sort"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+)
+const (
+	Quit     = "quit"
+	Back     = "back"
+	Palette  = "palette"
+	Theme    = "toggle_theme"
+	Up       = "up"
+	Down     = "down"
+	Confirm  = "confirm"
+	Toggle   = "toggle"
+	PageUp   = "page_up"
+	PageDown = "page_down"
+	Search   = "search"
+)
+type Action struct {
+	Name        string
+	Description string
+	Keys        []string
+	Key         string
+}
+var Actions = []Action{
+	{Name: Quit, Description: "Exit", Keys: []string{"q"}},
+	{Name: Back, Description: "Go back", Keys: []string{"esc"}},
+	{Name: Palette, Description: "Palette", Keys: []string{"ctrl+p", ":"}},
+	{Name: Theme, Description: "Toggle theme", Keys: []string{"t"}},
+	{Name: Up, Description: "Move up", Keys: []string{"up", "k"}, Key: "up"},
+	{Name: Down, Description: "Move down", Keys: []string{"down", "j"}, Key: "down"},
+	{Name: Confirm, Description: "Confirm", Keys: []string{"enter"}, Key: "enter"},
+	{Name: Toggle, Description: "Toggle", Keys: []string{"space"}, Key: "space"},
+	{Name: PageUp, Description: "Page up", Keys: []string{"pgup"}},
+	{Name: PageDown, Description: "Page down", Keys: []string{"pgdown"}},
+	{Name: Search, Description: "Search", Keys: []string{"/"}},
+}
+var presets = map[string]map[string]string{
+	"default": {},
+	"vim": {
+		Quit:     "q, Z Z, Z Q",
+		Back:     "esc, ctrl+o",
+		Palette:  ":",
+		PageUp:   "pgup, ctrl+b",
+		PageDown: "pgdown, ctrl+f",
+	},
+	"emacs": {
+		Quit:     "ctrl+x ctrl+c",
+		Back:     "esc, ctrl+g",
+		Palette:  "alt+x",
+		Up:       "up, ctrl+p",
+		Down:     "down, ctrl+n",
+		Confirm:  "enter, ctrl+j",
+		PageUp:   "pgup, alt+v",
+		PageDown: "pgdown, ctrl+v",
+		Search:   "ctrl+s",
+	},
+}
+type Result int
+const (
+	Unbound Result = iota
+	Pending
+	Matched
+	Cancelled
+)
+type Keymap struct {
+	preset    string
+	keys      map[string][]string
+	overrides map[string][]string
+	pending   []string
+}
+func New() *Keymap {
+	k := &Keymap{}
+	k.Apply("", nil)
+	return k
+}
+func Presets() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+func Parse(value string) []string {
+	var sequences []string
+	for _, alternative := range strings.Split(value, ",") {
+		if fields := strings.Fields(alternative); len(fields) > 0 {
+			sequences = append(sequences, strings.Join(fields, " "))
+		}
+	}
+	return sequences
+}
+func (k *Keymap) Apply(preset string, overrides map[string]string) {
+	preset = strings.ToLower(preset)
+	if _, ok := presets[preset]; !ok {
+		preset = "default"
+	}
+	k.preset = preset
+	k.keys = make(map[string][]string)
+	k.overrides = make(map[string][]string)
+	k.pending = nil
+	for _, action := range Actions {
+		k.keys[action.Name] = action.Keys
+	}
+	for name, value := range presets[preset] {
+		k.keys[name] = Parse(value)
+		k.overrides[name] = k.keys[name]
+	}
+	for name, value := range overrides {
+		if sequences := Parse(value); len(sequences) > 0 {
+			k.keys[name] = sequences
+			k.overrides[name] = sequences
+		}
+	}
+}
+func (k *Keymap) Preset() string {
+	return k.preset
+}
+func (k *Keymap) Keys(action string) []string {
+	return k.keys[action]
+}
+func (k *Keymap) Override(action string) ([]string, bool) {
+	sequences, ok := k.overrides[action]
+	return sequences, ok
+}
+func (k *Keymap) Display(action string) string {
+	if sequences := k.keys[action]; len(sequences) > 0 {
+		return sequences[0]
+	}
+	return ""
+}
+func (k *Keymap) Feed(key string, table map[string]string) (string, Result) {
+	k.pending = append(k.pending, key)
+	sequence := strings.Join(k.pending, " ")
+	if target, ok := table[sequence]; ok {
+		k.pending = nil
+		return target, Matched
+	}
+	for candidate := range table {
+		if strings.HasPrefix(candidate, sequence+" ") {
+			return "", Pending
+		}
+	}
+	chord := len(k.pending) > 1
+	k.pending = nil
+	if chord {
+		return "", Cancelled
+	}
+	return "", Unbound
+}
+func (k *Keymap) PendingKeys() string {
+	return strings.Join(k.pending, " ")
+}
+func Msg(key string) (tea.KeyMsg, bool) {
+	switch key {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}, true
+	case "space", " ":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}, true
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}, true
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}, true
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}, true
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}, true
+	case "left":
+		return tea.KeyMsg{Type: tea.KeyLeft}, true
+	case "right":
+		return tea.KeyMsg{Type: tea.KeyRight}, true
+	}
+	if runes := []rune(key); len(runes) == 1 {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: runes}, true
+	}
+	return tea.KeyMsg{}, false
+}
@@ -0,0 +1,101 @@
+package keymap
+import (
+	"reflect"
+	"testing"
+)
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "q", want: []string{"q"}},
+		{value: "q, Z Z, Z Q", want: []string{"q", "Z Z", "Z Q"}},
+		{value: "  ctrl+x   ctrl+c ,", want: []string{"ctrl+x ctrl+c"}},
+		{value: ", ,", want: nil},
+		{value: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := Parse(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		preset    string
+		overrides map[string]string
+		action    string
+		want      []string
+		custom    bool
+		active    string
+	}{
+		{name: "default keys", action: Quit, want: []string{"q"}, active: "default"},
+		{name: "unknown preset falls back to default", preset: "nano", action: Palette, want: []string{"ctrl+p", ":"}, active: "default"},
+		{name: "vim preset", preset: "vim", action: Quit, want: []string{"q", "Z Z", "Z Q"}, custom: true, active: "vim"},
+		{name: "preset name is case-insensitive", preset: "Emacs", action: Quit, want: []string{"ctrl+x ctrl+c"}, custom: true, active: "emacs"},
+		{name: "preset leaves other actions alone", preset: "vim", action: Up, want: []string{"up", "k"}, active: "vim"},
+		{name: "override on top of a preset", preset: "vim", overrides: map[string]string{Quit: "ctrl+q"}, action: Quit, want: []string{"ctrl+q"}, custom: true, active: "vim"},
+		{name: "empty override is ignored", overrides: map[string]string{Quit: " , "}, action: Quit, want: []string{"q"}, active: "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := New()
+			k.Apply(tt.preset, tt.overrides)
+			if got := k.Keys(tt.action); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keys(%s) = %q, want %q", tt.action, got, tt.want)
+			}
+			if _, custom := k.Override(tt.action); custom != tt.custom {
+				t.Errorf("Override(%s) custom = %v, want %v", tt.action, custom, tt.custom)
+			}
+			if k.Preset() != tt.active {
+				t.Errorf("Preset() = %q, want %q", k.Preset(), tt.active)
+			}
+		})
+	}
+}
+func TestFeed(t *testing.T) {
+	table := map[string]string{
+		"q":             "quit",
+		"Z Z":           "save",
+		"Z Q":           "discard",
+		"ctrl+x ctrl+c": "exit",
+	}
+	type step struct {
+		key    string
+		target string
+		result Result
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{name: "single key", steps: []step{{"q", "quit", Matched}}},
+		{name: "unbound key", steps: []step{{"x", "", Unbound}}},
+		{name: "chord", steps: []step{{"Z", "", Pending}, {"Z", "save", Matched}}},
+		{name: "second chord", steps: []step{{"Z", "", Pending}, {"Q", "discard", Matched}}},
+		{name: "modifier chord", steps: []step{{"ctrl+x", "", Pending}, {"ctrl+c", "exit", Matched}}},
+		{name: "wrong second key cancels", steps: []step{{"Z", "", Pending}, {"x", "", Cancelled}, {"q", "quit", Matched}}},
+		{name: "matched chord resets", steps: []step{{"Z", "", Pending}, {"Z", "save", Matched}, {"Z", "", Pending}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := New()
+			for i, s := range tt.steps {
+				target, result := k.Feed(s.key, table)
+				if target != s.target || result != s.result {
+					t.Fatalf("step %d Feed(%q) = (%q, %d), want (%q, %d)", i, s.key, target, result, s.target, s.result)
+				}
+			}
+		})
+	}
+}
+func TestPendingKeys(t *testing.T) {
+	k := New()
+	k.Feed("ctrl+x", map[string]string{"ctrl+x ctrl+c": "exit"})
+	if got := k.PendingKeys(); got != "ctrl+x" {
+		t.Errorf("PendingKeys() = %q, want %q", got, "ctrl+x")
+	}
+}
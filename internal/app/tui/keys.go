@@ -0,0 +1,226 @@
+package tui
+import (
+	"fmt"
+	"sort"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/keymap"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+)
+const (
+	targetRoute  = "route:"
+	targetAction = "action:"
+	targetKey    = "key:"
+	targetPress  = "press:"
+)
+type keyBinding struct {
+	sequence string
+	target   string
+	label    string
+	custom   bool
+}
+func isKeymapAction(name string) bool {
+	for _, action := range keymap.Actions {
+		if action.Name == name {
+			return true
+		}
+	}
+	return false
+}
+func (r *Router) bindings(path string) []keyBinding {
+	var bindings []keyBinding
+	for _, action := range keymap.Actions {
+		_, custom := r.keys.Override(action.Name)
+		if action.Key != "" && !custom {
+			continue
+		}
+		target := targetAction + action.Name
+		if action.Key != "" {
+			target = targetKey + action.Key
+		}
+		for _, sequence := range r.keys.Keys(action.Name) {
+			bindings = append(bindings, keyBinding{sequence: sequence, target: target, label: action.Description, custom: custom})
+		}
+	}
+	if route, ok := r.routes[path]; ok {
+		for _, kb := range route.Component.GetKeyBindings() {
+			if target := r.routeByAction(kb.Action); target != nil {
+				for _, sequence := range keymap.Parse(target.KeyBinding) {
+					bindings = append(bindings, keyBinding{sequence: sequence, target: targetRoute + target.Path, label: target.Title, custom: target.KeyBinding != target.DefaultKey})
+				}
+				continue
+			}
+			sequences, custom := r.keys.Override(kb.Action)
+			if !custom || isKeymapAction(kb.Action) || r.isRouteAction(kb.Action) {
+				continue
+			}
+			if _, ok := keymap.Msg(kb.Key); !ok {
+				continue
+			}
+			for _, sequence := range sequences {
+				bindings = append(bindings, keyBinding{sequence: sequence, target: targetKey + kb.Key, label: kb.Description, custom: true})
+			}
+		}
+	}
+	for _, route := range r.availableFrom(path) {
+		for _, sequence := range keymap.Parse(route.KeyBinding) {
+			bindings = append(bindings, keyBinding{sequence: sequence, target: targetRoute + route.Path, label: route.Title, custom: route.KeyBinding != route.DefaultKey})
+		}
+	}
+	return bindings
+}
+func (r *Router) routeByAction(action string) *Route {
+	path, ok := r.actions[action]
+	if !ok {
+		return nil
+	}
+	return r.routes[path]
+}
+func (r *Router) isRouteAction(action string) bool {
+	return r.routeByAction(action) != nil
+}
+func (r *Router) shadowed(key string) bool {
+	var actions []keymap.Action
+	actions = append(actions, keymap.Actions...)
+	if route := r.GetCurrentRoute(); route != nil {
+		for _, kb := range route.Component.GetKeyBindings() {
+			if !isKeymapAction(kb.Action) && !r.isRouteAction(kb.Action) {
+				actions = append(actions, keymap.Action{Name: kb.Action, Keys: []string{kb.Key}})
+			}
+		}
+	}
+	for _, action := range actions {
+		sequences, custom := r.keys.Override(action.Name)
+		if custom && contains(action.Keys, key) && !contains(sequences, key) {
+			return true
+		}
+	}
+	return false
+}
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+func (r *Router) dispatch(target string) tea.Cmd {
+	switch {
+	case strings.HasPrefix(target, targetRoute):
+		return r.navigate(strings.TrimPrefix(target, targetRoute))
+	case strings.HasPrefix(target, targetKey):
+		msg, _ := keymap.Msg(strings.TrimPrefix(target, targetKey))
+		if route := r.GetCurrentRoute(); route != nil {
+			_, cmd := route.Component.HandleInput(msg)
+			return cmd
+		}
+		return nil
+	}
+	action := strings.TrimPrefix(target, targetAction)
+	if handler, ok := r.handlers[action]; ok {
+		return handler()
+	}
+	switch action {
+	case keymap.Quit:
+		return tea.Quit
+	case keymap.Back:
+		return r.back()
+	case keymap.PageUp:
+		r.viewport().PageUp()
+	case keymap.PageDown:
+		r.viewport().PageDown()
+	case keymap.Search:
+		r.viewport().StartSearch()
+	}
+	return nil
+}
+func (r *Router) HandleAction(action string, handler func() tea.Cmd) {
+	r.handlers[action] = handler
+}
+func (r *Router) handleKey(msg tea.KeyMsg) (bool, tea.Cmd) {
+	key := msg.String()
+	if key == " " {
+		key = "space"
+	}
+	table := make(map[string]string)
+	for _, binding := range r.bindings(r.currentRoute) {
+		table[binding.sequence] = binding.target
+	}
+	target, result := r.keys.Feed(key, table)
+	switch result {
+	case keymap.Matched:
+		return true, r.dispatch(target)
+	case keymap.Pending, keymap.Cancelled:
+		return true, nil
+	}
+	if r.shadowed(key) {
+		return true, nil
+	}
+	if currentRoute := r.GetCurrentRoute(); currentRoute != nil {
+		return currentRoute.Component.HandleInput(msg)
+	}
+	return true, nil
+}
+func (r *Router) KeymapConflicts() []string {
+	seen := make(map[string]bool)
+	var conflicts []string
+	for _, route := range r.sortedRoutes() {
+		bindings := r.bindings(route.Path)
+		for i, a := range bindings {
+			for _, b := range bindings[i+1:] {
+				if a.target == b.target || !(a.custom || b.custom) {
+					continue
+				}
+				var conflict string
+				switch {
+				case a.sequence == b.sequence:
+					conflict = fmt.Sprintf("%q is bound to both %s and %s on %s", a.sequence, a.label, b.label, route.Title)
+				case strings.HasPrefix(b.sequence, a.sequence+" "):
+					conflict = fmt.Sprintf("%q (%s) hides %q (%s) on %s", a.sequence, a.label, b.sequence, b.label, route.Title)
+				case strings.HasPrefix(a.sequence, b.sequence+" "):
+					conflict = fmt.Sprintf("%q (%s) hides %q (%s) on %s", b.sequence, b.label, a.sequence, a.label, route.Title)
+				}
+				if conflict != "" && !seen[conflict] {
+					seen[conflict] = true
+					conflicts = append(conflicts, conflict)
+				}
+			}
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+func (r *Router) effectiveKey(kb types.KeyBinding) string {
+	for _, route := range r.routes {
+		if route.Action == kb.Action {
+			return route.KeyBinding
+		}
+	}
+	if kb.Key == "↑/↓" {
+		_, up := r.keys.Override(keymap.Up)
+		_, down := r.keys.Override(keymap.Down)
+		if up || down {
+			return strings.Join(r.keys.Keys(keymap.Up), ",") + "/" + strings.Join(r.keys.Keys(keymap.Down), ",")
+		}
+		return kb.Key
+	}
+	if sequences, ok := r.keys.Override(kb.Action); ok {
+		return strings.Join(sequences, "/")
+	}
+	return kb.Key
+}
+func (r *Router) GlobalBindings() []types.KeyBinding {
+	bindings := []types.KeyBinding{{Key: "ctrl+c", Description: "Exit", Action: "exit"}}
+	for _, action := range keymap.Actions {
+		bindings = append(bindings, types.KeyBinding{
+			Key:         strings.Join(r.keys.Keys(action.Name), ", "),
+			Description: action.Description,
+			Action:      action.Name,
+		})
+	}
+	return bindings
+}
+func (r *Router) KeymapPreset() string {
+	return r.keys.Preset()
+}
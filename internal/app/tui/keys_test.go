@@ -0,0 +1,65 @@
+package tui
+import (
+	"reflect"
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+)
+func TestKeymapConflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		preset    string
+		overrides map[string]string
+		want      []string
+	}{
+		{name: "defaults"},
+		{name: "vim preset", preset: "vim"},
+		{name: "emacs preset", preset: "emacs"},
+		{name: "route rebound onto a sibling", overrides: map[string]string{"navigate_langs": "c"}, want: []string{`"c" is bound to both Languages and Config on Home`}},
+		{name: "action rebound onto a route", overrides: map[string]string{"quit": "l"}, want: []string{`"l" is bound to both Exit and Languages on Home`}},
+		{name: "page action rebound onto a route", overrides: map[string]string{"refresh": "l"}, want: []string{`"l" is bound to both Refresh and Languages on Home`}},
+		{name: "key hides a chord", overrides: map[string]string{"quit": "g", "navigate_langs": "g g"}, want: []string{`"g" (Exit) hides "g g" (Languages) on Home`, `"g" is bound to both Exit and Go on Languages`}},
+		{name: "key hides preset chords", preset: "vim", overrides: map[string]string{"navigate_langs": "Z"}, want: []string{`"Z" (Languages) hides "Z Q" (Exit) on Home`, `"Z" (Languages) hides "Z Z" (Exit) on Home`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRouter()
+			r.ApplyKeymap(tt.preset, tt.overrides)
+			if got := r.KeymapConflicts(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeymapConflicts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+func TestHandleInputChords(t *testing.T) {
+	r := newTestRouter()
+	r.ApplyKeymap("vim", map[string]string{"navigate_langs": "g l"})
+	key := func(k string) tea.KeyMsg {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+	}
+	if handled, cmd := r.HandleInput(key("g")); !handled || cmd != nil {
+		t.Fatalf("first chord key = (%v, %v), want it held", handled, cmd)
+	}
+	if r.keys.PendingKeys() != "g" {
+		t.Errorf("PendingKeys() = %q, want g", r.keys.PendingKeys())
+	}
+	r.HandleInput(key("l"))
+	if r.GetCurrentRoute().Path != "/langs" {
+		t.Fatalf("g l should open /langs, on %s", r.GetCurrentRoute().Path)
+	}
+	r.HandleInput(key("Z"))
+	if _, cmd := r.HandleInput(key("Q")); cmd == nil {
+		t.Error("Z Q should quit with the vim preset")
+	}
+}
+func TestPageBindingsReachRoutes(t *testing.T) {
+	r := newTestRouter()
+	helpRoute, _ := r.RegisterRoute("/help", &stubPage{title: "Help", bindings: []types.KeyBinding{{Key: "l", Description: "Languages", Action: "navigate_langs"}}}, "Help", "", "?")
+	helpRoute.WithScope(ScopeGlobal)
+	r.ApplyKeymap("", map[string]string{"navigate_langs": "L"})
+	r.NavigateTo("/help")
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+	if r.GetCurrentRoute().Path != "/langs" {
+		t.Errorf("the help page's navigate_langs key should open /langs with its rebound key, on %s", r.GetCurrentRoute().Path)
+	}
+}
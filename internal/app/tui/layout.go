@@ -0,0 +1,85 @@
+package tui
+import (
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+)
+const (
+	compactWidth  = 80
+	compactHeight = 24
+)
+type layout struct {
+	header  string
+	footer  string
+	status  string
+	width   int
+	height  int
+	content lipgloss.Style
+}
+func (r *Router) viewport() *viewport.Viewport {
+	v, ok := r.viewports[r.currentRoute]
+	if !ok {
+		v = viewport.New()
+		r.viewports[r.currentRoute] = v
+	}
+	return v
+}
+func (r *Router) SetCompact(compact bool) {
+	r.compact = compact
+}
+func (r *Router) Compact() bool {
+	return r.compact
+}
+func (r *Router) contentStyle() lipgloss.Style {
+	if r.compact {
+		return r.styles.Content.Padding(0)
+	}
+	return r.styles.Content
+}
+func (r *Router) footerStyle(width int) lipgloss.Style {
+	if r.compact {
+		return lipgloss.NewStyle().Foreground(r.theme.Muted).Width(width).MaxHeight(1)
+	}
+	return r.styles.Footer.Width(width)
+}
+func (r *Router) scrollViewport(msg tea.MouseMsg) {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		r.viewport().ScrollBy(-3)
+	case tea.MouseButtonWheelDown:
+		r.viewport().ScrollBy(3)
+	}
+}
+func (m *Model) appStyle() lipgloss.Style {
+	if m.router.Compact() {
+		return m.styles.App.Padding(0, 1)
+	}
+	return m.styles.App
+}
+func (m *Model) layout() layout {
+	app := m.appStyle()
+	l := layout{
+		width:   max(1, m.width-app.GetHorizontalFrameSize()),
+		content: m.router.contentStyle(),
+	}
+	l.header = m.router.RenderHeader(l.width)
+	l.footer = m.router.RenderFooter(l.width)
+	l.status = m.router.RenderStatusBar(l.width)
+	used := app.GetVerticalFrameSize() + l.content.GetVerticalFrameSize()
+	for _, section := range []string{l.header, l.footer, l.status} {
+		used += lipgloss.Height(section)
+	}
+	l.height = max(1, m.height-used)
+	return l
+}
+func (l layout) render(content string) string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > l.height {
+		lines = lines[:l.height]
+	}
+	for len(lines) < l.height {
+		lines = append(lines, "")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, l.header, l.content.Render(strings.Join(lines, "\n")), l.footer, l.status)
+}
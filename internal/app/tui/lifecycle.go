@@ -0,0 +1,63 @@
+package tui
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+)
+func (r *Router) switchTo(path string) tea.Cmd {
+	var cmds []tea.Cmd
+	if current := r.GetCurrentRoute(); current != nil {
+		if leaver, ok := current.Component.(types.Leaver); ok {
+			cmds = append(cmds, leaver.OnLeave())
+		}
+	}
+	r.currentRoute = path
+	cmds = append(cmds, r.enter())
+	return tea.Batch(cmds...)
+}
+func (r *Router) enter() tea.Cmd {
+	if current := r.GetCurrentRoute(); current != nil {
+		if enterer, ok := current.Component.(types.Enterer); ok {
+			return enterer.OnEnter()
+		}
+	}
+	return nil
+}
+func (r *Router) Init() tea.Cmd {
+	var cmds []tea.Cmd
+	for _, route := range r.sortedRoutes() {
+		if initializer, ok := route.Component.(types.Initializer); ok {
+			cmds = append(cmds, initializer.Init())
+		}
+	}
+	if r.started {
+		cmds = append(cmds, r.start)
+	} else {
+		cmds = append(cmds, r.enter())
+	}
+	return tea.Batch(cmds...)
+}
+func (r *Router) Start(target string) error {
+	from := r.currentRoute
+	cmd, err := r.NavigateTo(target)
+	if err != nil {
+		return err
+	}
+	r.start, r.started = cmd, r.currentRoute != from
+	return nil
+}
+func (r *Router) Update(msg tea.Msg) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, route := range r.sortedRoutes() {
+		if updater, ok := route.Component.(types.Updater); ok {
+			cmds = append(cmds, updater.Update(msg))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+func (r *Router) Resize(width, height int) {
+	for _, route := range r.routes {
+		if resizer, ok := route.Component.(types.Resizer); ok {
+			resizer.Resize(width, height)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package tui
+import (
+	"strings"
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+)
+type hookMsg string
+type hookPage struct {
+	stubPage
+	events []string
+}
+func (p *hookPage) Init() tea.Cmd {
+	p.events = append(p.events, "init")
+	return nil
+}
+func (p *hookPage) Update(msg tea.Msg) tea.Cmd {
+	if msg, ok := msg.(hookMsg); ok {
+		p.events = append(p.events, "update:"+string(msg))
+	}
+	return nil
+}
+func (p *hookPage) OnEnter() tea.Cmd {
+	p.events = append(p.events, "enter")
+	return func() tea.Msg { return hookMsg(p.title) }
+}
+func (p *hookPage) OnLeave() tea.Cmd {
+	p.events = append(p.events, "leave")
+	return nil
+}
+func (p *hookPage) Resize(width, height int) {
+	p.events = append(p.events, "resize")
+}
+func TestLifecycleHooks(t *testing.T) {
+	home := &hookPage{stubPage: stubPage{title: "Home"}}
+	langs := &hookPage{stubPage: stubPage{title: "Languages"}}
+	r := NewRouter()
+	r.RegisterRoute("/", home, "Home", "", "h")
+	r.RegisterRoute("/langs", langs, "Languages", "", "l")
+	r.Init()
+	r.Update(hookMsg("tick"))
+	r.Resize(80, 24)
+	cmd, err := r.NavigateTo("/langs")
+	if err != nil || cmd == nil {
+		t.Fatalf("NavigateTo() = (%v, %v), want the enter command", cmd, err)
+	}
+	if again, _ := r.NavigateTo("/langs"); again != nil {
+		t.Error("navigating to the current route should not re-enter it")
+	}
+	r.GoBack()
+	want := map[*hookPage][]string{
+		home:  {"init", "enter", "update:tick", "resize", "leave", "enter"},
+		langs: {"init", "update:tick", "resize", "enter", "leave"},
+	}
+	for page, events := range want {
+		if len(page.events) != len(events) {
+			t.Errorf("%s events = %v, want %v", page.title, page.events, events)
+			continue
+		}
+		for i := range events {
+			if page.events[i] != events[i] {
+				t.Errorf("%s events = %v, want %v", page.title, page.events, events)
+				break
+			}
+		}
+	}
+}
+func TestStartEntersOnce(t *testing.T) {
+	home := &hookPage{stubPage: stubPage{title: "Home"}}
+	langs := &hookPage{stubPage: stubPage{title: "Languages"}}
+	r := NewRouter()
+	r.RegisterRoute("/", home, "Home", "", "h")
+	r.RegisterRoute("/langs", langs, "Languages", "", "l")
+	if err := r.Start("/langs"); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	r.Init()
+	if got := strings.Join(langs.events, ","); got != "enter,init" {
+		t.Errorf("start route events = %s, want enter,init", got)
+	}
+	if got := strings.Join(home.events, ","); got != "leave,init" {
+		t.Errorf("home events = %s, want leave,init", got)
+	}
+	if err := NewRouter().Start("/missing"); err == nil {
+		t.Error("Start() should fail for an unknown route")
+	}
+}
@@ -0,0 +1,56 @@
+package tui
+import (
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/keymap"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
+)
+const footerZone = "footer:"
+func footerItem(target, item string) string {
+	if target == "" {
+		return item
+	}
+	return zone.Mark(footerZone+target, item)
+}
+func (r *Router) footerTarget(kb types.KeyBinding) string {
+	for _, route := range r.routes {
+		if route.Action == kb.Action {
+			return targetRoute + route.Path
+		}
+	}
+	if kb.Key == "esc" {
+		return targetPress + kb.Key
+	}
+	if _, ok := keymap.Msg(kb.Key); ok {
+		return targetKey + kb.Key
+	}
+	return ""
+}
+func (r *Router) Scan(view string) string {
+	return r.zones.Scan(view)
+}
+func (r *Router) HandleMouse(msg tea.MouseMsg) tea.Cmd {
+	var id string
+	if z, ok := r.zones.At(msg.X, msg.Y); ok {
+		id = z.ID
+	}
+	if target, ok := strings.CutPrefix(id, footerZone); ok {
+		if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+			return nil
+		}
+		if key, ok := strings.CutPrefix(target, targetPress); ok {
+			press, _ := keymap.Msg(key)
+			_, cmd := r.HandleInput(press)
+			return cmd
+		}
+		return r.dispatch(target)
+	}
+	if currentRoute := r.GetCurrentRoute(); currentRoute != nil {
+		if handler, ok := currentRoute.Component.(types.MouseHandler); ok {
+			return handler.HandleMouse(msg, id)
+		}
+	}
+	r.scrollViewport(msg)
+	return nil
+}
@@ -0,0 +1,25 @@
+package tui
+import (
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+)
+func TestHandleMouseFooter(t *testing.T) {
+	r := newTestRouter()
+	r.Scan(r.RenderFooter(120))
+	click := func(id string) tea.Cmd {
+		z, ok := r.zones.Get(footerZone + id)
+		if !ok {
+			t.Fatalf("no footer zone %s", id)
+		}
+		return r.HandleMouse(tea.MouseMsg{X: z.StartX, Y: z.StartY, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	}
+	click(targetKey + "r")
+	if page := r.routes["/"].Component.(*stubPage); len(page.keys) != 1 || page.keys[0] != "r" {
+		t.Errorf("clicking [r] Refresh sent %v to the page, want [r]", page.keys)
+	}
+	z, _ := r.zones.Get(footerZone + targetKey + "r")
+	r.HandleMouse(tea.MouseMsg{X: z.StartX, Y: z.StartY, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	if page := r.routes["/"].Component.(*stubPage); len(page.keys) != 1 {
+		t.Error("a release should not trigger a footer item")
+	}
+}
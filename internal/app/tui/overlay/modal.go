@@ -0,0 +1,116 @@
+package overlay
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+)
+type Modal struct {
+	msg      ModalMsg
+	styles   *theme.Styles
+	value    string
+	selected int
+}
+func NewModal(msg ModalMsg, styles *theme.Styles) *Modal {
+	return &Modal{
+		msg:    msg,
+		styles: styles,
+		value:  msg.Value,
+	}
+}
+func (m *Modal) ID() string {
+	return m.msg.ID
+}
+func (m *Modal) result(ok bool) tea.Cmd {
+	result := ResultMsg{ID: m.msg.ID, OK: ok, Value: m.value, Index: m.selected}
+	if m.msg.Kind == KindSelect && ok {
+		result.Value = m.msg.Options[m.selected]
+	}
+	return func() tea.Msg {
+		return result
+	}
+}
+func (m *Modal) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		return true, m.result(false)
+	case "enter":
+		if m.msg.Kind == KindConfirm {
+			return true, m.result(m.selected == 0)
+		}
+		if m.msg.Kind == KindSelect && len(m.msg.Options) == 0 {
+			return true, m.result(false)
+		}
+		return true, m.result(true)
+	}
+	switch m.msg.Kind {
+	case KindConfirm:
+		switch msg.String() {
+		case "left", "right", "tab", "shift+tab", "h", "l":
+			m.selected = 1 - m.selected
+		case "y":
+			return true, m.result(true)
+		case "n":
+			return true, m.result(false)
+		}
+	case KindPrompt:
+		switch msg.Type {
+		case tea.KeyBackspace:
+			if runes := []rune(m.value); len(runes) > 0 {
+				m.value = string(runes[:len(runes)-1])
+			}
+		case tea.KeyCtrlU:
+			m.value = ""
+		case tea.KeyRunes, tea.KeySpace:
+			m.value += string(msg.Runes)
+		}
+	case KindSelect:
+		switch msg.String() {
+		case "up", "k", "shift+tab":
+			if m.selected > 0 {
+				m.selected--
+			}
+		case "down", "j", "tab":
+			if m.selected < len(m.msg.Options)-1 {
+				m.selected++
+			}
+		}
+	}
+	return false, nil
+}
+func (m *Modal) Render(width int) string {
+	var content []string
+	content = append(content, lipgloss.NewStyle().Bold(true).Render(m.msg.Title))
+	if m.msg.Message != "" {
+		content = append(content, "", m.msg.Message)
+	}
+	content = append(content, "")
+	switch m.msg.Kind {
+	case KindConfirm:
+		buttons := []string{"Yes", "No"}
+		for i, button := range buttons {
+			style := m.styles.Button
+			if i == m.selected {
+				style = m.styles.ButtonFocus
+			}
+			buttons[i] = style.Render(button)
+		}
+		content = append(content, lipgloss.JoinHorizontal(lipgloss.Top, buttons[0], " ", buttons[1]))
+		content = append(content, "", "[y/n] Answer  [←/→] Switch  [esc] Cancel")
+	case KindPrompt:
+		content = append(content, "> "+m.value+"█", "", "[enter] Confirm  [esc] Cancel")
+	case KindSelect:
+		for i, option := range m.msg.Options {
+			prefix := "  "
+			if i == m.selected {
+				prefix = "▶ "
+			}
+			content = append(content, fmt.Sprintf("%s%s", prefix, option))
+		}
+		content = append(content, "", "[↑/↓] Select  [enter] Choose  [esc] Cancel")
+	}
+	return m.styles.Panel.
+		MarginBottom(0).
+		Width(min(60, max(20, width-4))).
+		Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
@@ -0,0 +1,43 @@
+package overlay
+import (
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+)
+func press(m *Modal, keys ...tea.KeyMsg) ResultMsg {
+	for _, key := range keys {
+		if done, cmd := m.HandleInput(key); done {
+			return cmd().(ResultMsg)
+		}
+	}
+	return ResultMsg{ID: "unfinished"}
+}
+func TestModalResults(t *testing.T) {
+	styles := theme.NewStyles(theme.Themeless())
+	runes := func(s string) tea.KeyMsg {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+	}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	esc := tea.KeyMsg{Type: tea.KeyEsc}
+	tests := []struct {
+		name string
+		msg  ModalMsg
+		keys []tea.KeyMsg
+		want ResultMsg
+	}{
+		{name: "confirm defaults to yes", msg: ModalMsg{ID: "reset", Kind: KindConfirm}, keys: []tea.KeyMsg{enter}, want: ResultMsg{ID: "reset", OK: true}},
+		{name: "confirm switched to no", msg: ModalMsg{ID: "reset", Kind: KindConfirm}, keys: []tea.KeyMsg{{Type: tea.KeyRight}, enter}, want: ResultMsg{ID: "reset", Index: 1}},
+		{name: "confirm with n", msg: ModalMsg{ID: "reset", Kind: KindConfirm}, keys: []tea.KeyMsg{runes("n")}, want: ResultMsg{ID: "reset"}},
+		{name: "prompt edits the value", msg: ModalMsg{ID: "name", Kind: KindPrompt, Value: "ap"}, keys: []tea.KeyMsg{runes("x"), {Type: tea.KeyBackspace}, runes("i"), enter}, want: ResultMsg{ID: "name", OK: true, Value: "api"}},
+		{name: "prompt cancelled", msg: ModalMsg{ID: "name", Kind: KindPrompt, Value: "api"}, keys: []tea.KeyMsg{esc}, want: ResultMsg{ID: "name", Value: "api"}},
+		{name: "select picks an option", msg: ModalMsg{ID: "driver", Kind: KindSelect, Options: []string{"none", "postgres", "mysql"}}, keys: []tea.KeyMsg{runes("j"), runes("j"), runes("j"), runes("k"), enter}, want: ResultMsg{ID: "driver", OK: true, Value: "postgres", Index: 1}},
+		{name: "select without options", msg: ModalMsg{ID: "driver", Kind: KindSelect}, keys: []tea.KeyMsg{enter}, want: ResultMsg{ID: "driver"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := press(NewModal(tt.msg, styles), tt.keys...); got != tt.want {
+				t.Errorf("result = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Package overlay
+package overlay
+import (
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+type Kind int
+const (
+	KindConfirm Kind = iota
+	KindPrompt
+	KindSelect
+)
+type Severity int
+const (
+	Info Severity = iota
+	Success
+	Warning
+	Error
+)
+type ModalMsg struct {
+	ID      string
+	Kind    Kind
+	Title   string
+	Message string
+	Value   string
+	Options []string
+}
+type ResultMsg struct {
+	ID    string
+	OK    bool
+	Value string
+	Index int
+}
+type ToastMsg struct {
+	Severity Severity
+	Text     string
+}
+func Confirm(id, title, message string) tea.Cmd {
+	return func() tea.Msg {
+		return ModalMsg{ID: id, Kind: KindConfirm, Title: title, Message: message}
+	}
+}
+func Prompt(id, title, message, value string) tea.Cmd {
+	return func() tea.Msg {
+		return ModalMsg{ID: id, Kind: KindPrompt, Title: title, Message: message, Value: value}
+	}
+}
+func Select(id, title string, options []string) tea.Cmd {
+	return func() tea.Msg {
+		return ModalMsg{ID: id, Kind: KindSelect, Title: title, Options: options}
+	}
+}
+func Toast(severity Severity, text string) tea.Cmd {
+	return func() tea.Msg {
+		return ToastMsg{Severity: severity, Text: text}
+	}
+}
+func Place(base, box string, x, y int) string {
+	lines := strings.Split(base, "\n")
+	for i, row := range strings.Split(box, "\n") {
+		if y+i < 0 || y+i >= len(lines) {
+			continue
+		}
+		line := lines[y+i]
+		left := ansi.Truncate(line, x, "")
+		if width := ansi.StringWidth(left); width < x {
+			left += strings.Repeat(" ", x-width)
+		}
+		right := ansi.TruncateLeft(line, x+ansi.StringWidth(row), "")
+		lines[y+i] = left + "\x1b[0m" + row + "\x1b[0m" + right
+	}
+	return strings.Join(lines, "\n")
+}
+func Center(base, box string, width, height int) string {
+	return Place(base, box, max(0, (width-lipgloss.Width(box))/2), max(0, (height-lipgloss.Height(box))/2))
+}
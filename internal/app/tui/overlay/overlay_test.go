@@ -0,0 +1,22 @@
+package overlay
+import (
+	"strings"
+	"testing"
+	"github.com/charmbracelet/x/ansi"
+)
+func TestPlace(t *testing.T) {
+	base := "0123456789\nabcdefghij\nABCDEFGHIJ"
+	got := strings.Split(ansi.Strip(Place(base, "XX\nYY\nZZ", 3, 1)), "\n")
+	want := []string{"0123456789", "abcXXfghij", "ABCYYFGHIJ"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if got := ansi.Strip(Place("ab", "XY", 4, 0)); got != "ab  XY" {
+		t.Errorf("Place() past the end = %q, want the line padded", got)
+	}
+	if got := ansi.Strip(Center("..........\n..........\n..........", "[]", 10, 3)); got != "..........\n....[]....\n.........." {
+		t.Errorf("Center() = %q", got)
+	}
+}
@@ -0,0 +1,87 @@
+package overlay
+import (
+	"fmt"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+)
+const maxToasts = 3
+type toast struct {
+	id       int
+	severity Severity
+	text     string
+}
+type expiredMsg struct {
+	id int
+}
+type Toasts struct {
+	styles  *theme.Styles
+	visible []toast
+	queue   []toast
+	next    int
+}
+func NewToasts(styles *theme.Styles) *Toasts {
+	return &Toasts{styles: styles}
+}
+func (t *Toasts) SetStyles(styles *theme.Styles) {
+	t.styles = styles
+}
+func (t *Toasts) Push(severity Severity, text string) tea.Cmd {
+	t.next++
+	t.queue = append(t.queue, toast{id: t.next, severity: severity, text: text})
+	return t.promote()
+}
+func (t *Toasts) promote() tea.Cmd {
+	var cmds []tea.Cmd
+	for len(t.visible) < maxToasts && len(t.queue) > 0 {
+		next := t.queue[0]
+		t.queue = t.queue[1:]
+		t.visible = append(t.visible, next)
+		duration := 4 * time.Second
+		if next.severity == Error || next.severity == Warning {
+			duration = 8 * time.Second
+		}
+		cmds = append(cmds, tea.Tick(duration, func(time.Time) tea.Msg {
+			return expiredMsg{id: next.id}
+		}))
+	}
+	return tea.Batch(cmds...)
+}
+func (t *Toasts) Update(msg tea.Msg) (bool, tea.Cmd) {
+	expired, ok := msg.(expiredMsg)
+	if !ok {
+		return false, nil
+	}
+	for i, shown := range t.visible {
+		if shown.id == expired.id {
+			t.visible = append(t.visible[:i], t.visible[i+1:]...)
+			break
+		}
+	}
+	return true, t.promote()
+}
+func (t *Toasts) Empty() bool {
+	return len(t.visible) == 0
+}
+func (t *Toasts) style(severity Severity) lipgloss.Style {
+	switch severity {
+	case Success:
+		return t.styles.Success
+	case Warning:
+		return t.styles.Warning
+	case Error:
+		return t.styles.Error
+	}
+	return t.styles.Info
+}
+func (t *Toasts) Render(width int) string {
+	var boxes []string
+	for _, shown := range t.visible {
+		boxes = append(boxes, t.style(shown.severity).Padding(0, 1).Width(min(50, max(10, width-4))).Render(shown.text))
+	}
+	if len(t.queue) > 0 {
+		boxes = append(boxes, lipgloss.NewStyle().Faint(true).Render(lipgloss.PlaceHorizontal(lipgloss.Width(boxes[0]), lipgloss.Right, fmt.Sprintf("+%d more", len(t.queue)))))
+	}
+	return lipgloss.JoinVertical(lipgloss.Right, boxes...)
+}
@@ -0,0 +1,35 @@
+package overlay
+import (
+	"strings"
+	"testing"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+)
+func TestToastQueue(t *testing.T) {
+	toasts := NewToasts(theme.NewStyles(theme.Themeless()))
+	if !toasts.Empty() {
+		t.Fatal("new toasts should be empty")
+	}
+	for i, text := range []string{"one", "two", "three", "four", "five"} {
+		cmd := toasts.Push(Severity(i%4), text)
+		if (cmd != nil) != (i < maxToasts) {
+			t.Errorf("Push(%s) cmd = %v, want a timer only while there is room", text, cmd)
+		}
+	}
+	if len(toasts.visible) != maxToasts || !strings.Contains(toasts.Render(80), "+2 more") {
+		t.Fatalf("Render() = %q, want three toasts and +2 more", toasts.Render(80))
+	}
+	if handled, _ := toasts.Update("tick"); handled {
+		t.Error("Update() should ignore unrelated messages")
+	}
+	handled, cmd := toasts.Update(expiredMsg{id: 2})
+	if !handled || cmd == nil {
+		t.Fatal("an expired toast should make room for the next one")
+	}
+	var texts []string
+	for _, shown := range toasts.visible {
+		texts = append(texts, shown.text)
+	}
+	if strings.Join(texts, ",") != "one,three,four" || len(toasts.queue) != 1 {
+		t.Errorf("visible = %v with %d queued, want one,three,four with 1 queued", texts, len(toasts.queue))
+	}
+}
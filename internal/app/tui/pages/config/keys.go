@@ -0,0 +1,134 @@
+package config
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+)
+type Keymap interface {
+	RouteBindings() []types.RouteBinding
+	KeyConflict(path, key string) (string, bool)
+}
+type KeysPage struct {
+	styles        *PageStyles
+	keymap        Keymap
+	selectedIndex int
+	capturing     bool
+	err           string
+}
+func NewKeysPage(keymap Keymap) *KeysPage {
+	return &KeysPage{
+		styles: NewPageStyles(),
+		keymap: keymap,
+	}
+}
+func (p *KeysPage) Render(width, height int) string {
+	bindings := p.keymap.RouteBindings()
+	var content []string
+	content = append(content, p.styles.Title.Render("⌨️  Keybindings"))
+	content = append(content, p.styles.Description.Render("Shortcuts that open each page from its parent. Enter rebinds, d restores the default."))
+	for i, binding := range bindings {
+		line := fmt.Sprintf("%-8s %-28s %s", "["+binding.Key+"]", binding.Title, binding.Path)
+		if binding.Key != binding.DefaultKey {
+			line += " (default " + binding.DefaultKey + ")"
+		}
+		style := p.styles.Option
+		prefix := "  "
+		if i == p.selectedIndex {
+			style = p.styles.OptionFocus
+			prefix = "▶ "
+		}
+		item := style.Render(prefix + line)
+		if i == p.selectedIndex {
+			item = viewport.Focus(item)
+		}
+		content = append(content, item)
+	}
+	if p.capturing {
+		content = append(content, "", p.styles.Success.Render("Press the new key for "+bindings[p.selectedIndex].Title+" (enter or esc cancels)"))
+	}
+	if p.err != "" {
+		content = append(content, "", p.styles.Error.Render("❌ "+p.err))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *KeysPage) bind(binding types.RouteBinding, key string) tea.Cmd {
+	p.err = ""
+	if key == binding.Key {
+		return nil
+	}
+	if other, conflict := p.keymap.KeyConflict(binding.Path, key); conflict {
+		p.err = fmt.Sprintf("%q is already bound to %s", key, other)
+		return nil
+	}
+	cmd, err := save(func(store *configfile.Store) {
+		if key == binding.DefaultKey {
+			store.Unset("keymap." + binding.Action)
+		} else {
+			store.Set("keymap."+binding.Action, key)
+		}
+	})
+	if err != nil {
+		p.err = err.Error()
+		return cmd
+	}
+	if note := overridden("keymap." + binding.Action); note != "" {
+		return tea.Batch(cmd, overlay.Toast(overlay.Warning, "⚠️  "+binding.Title+" "+note))
+	}
+	return tea.Batch(cmd, overlay.Toast(overlay.Success, fmt.Sprintf("✅ %s is now [%s]", binding.Title, key)))
+}
+func (p *KeysPage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	bindings := p.keymap.RouteBindings()
+	if len(bindings) == 0 {
+		return true, nil
+	}
+	if p.capturing {
+		p.capturing = false
+		switch msg.String() {
+		case "enter", "esc":
+			return true, nil
+		}
+		return true, p.bind(bindings[p.selectedIndex], msg.String())
+	}
+	switch msg.String() {
+	case "up", "k":
+		if p.selectedIndex > 0 {
+			p.selectedIndex--
+		}
+	case "down", "j":
+		if p.selectedIndex < len(bindings)-1 {
+			p.selectedIndex++
+		}
+	case "enter":
+		p.capturing = true
+		p.err = ""
+	case "d":
+		return true, p.bind(bindings[p.selectedIndex], bindings[p.selectedIndex].DefaultKey)
+	}
+	return true, nil
+}
+func (p *KeysPage) GetTitle() string {
+	return "Keybindings"
+}
+func (p *KeysPage) CapturesInput() bool {
+	return p.capturing
+}
+func (p *KeysPage) OnLeave() tea.Cmd {
+	p.capturing = false
+	return nil
+}
+func (p *KeysPage) GetKeyBindings() []types.KeyBinding {
+	if p.capturing {
+		return []types.KeyBinding{
+			{Key: "esc", Description: "Cancel", Action: "cancel_rebind"},
+		}
+	}
+	return []types.KeyBinding{
+		{Key: "↑/↓", Description: "Select", Action: "select"},
+		{Key: "enter", Description: "Rebind", Action: "rebind_key"},
+		{Key: "d", Description: "Default", Action: "default_key"},
+	}
+}
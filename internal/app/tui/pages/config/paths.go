@@ -0,0 +1,154 @@
+package config
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+)
+type pathField struct {
+	key         string
+	label       string
+	description string
+}
+var pathFields = []pathField{
+	{key: "paths.projects", label: "Projects", description: "Directory new projects are created in"},
+	{key: "paths.gobin", label: "GOBIN", description: "Where go-blueprint and other tools are installed"},
+}
+type PathsPage struct {
+	styles        *PageStyles
+	selectedIndex int
+	editing       bool
+	input         string
+	err           string
+}
+func NewPathsPage() *PathsPage {
+	return &PathsPage{
+		styles: NewPageStyles(),
+	}
+}
+func (p *PathsPage) value(field pathField) string {
+	paths := configfile.Current().Paths
+	if field.key == "paths.gobin" {
+		return paths.GoBin
+	}
+	return paths.Projects
+}
+func (p *PathsPage) Render(width, height int) string {
+	var content []string
+	content = append(content, p.styles.Title.Render("📁 Paths"))
+	content = append(content, p.styles.Description.Render("Enter edits the selected path, an empty value restores the default."))
+	for i, field := range pathFields {
+		value := p.value(field)
+		if value == "" {
+			value = "(not set)"
+		}
+		if i == p.selectedIndex && p.editing {
+			value = p.input + "█"
+		}
+		style := p.styles.Option
+		prefix := "  "
+		if i == p.selectedIndex {
+			style = p.styles.OptionFocus
+			prefix = "▶ "
+		}
+		item := style.Render(prefix + field.label + ": " + value)
+		if i == p.selectedIndex {
+			item = viewport.Focus(item)
+		}
+		content = append(content, item)
+		content = append(content, p.styles.Option.Render("    "+field.description))
+	}
+	if p.err != "" {
+		content = append(content, "", p.styles.Error.Render("❌ "+p.err))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *PathsPage) save(field pathField, value string) tea.Cmd {
+	p.err = ""
+	value = strings.TrimSpace(value)
+	cmd, err := save(func(store *configfile.Store) {
+		if value == "" {
+			store.Unset(field.key)
+		} else {
+			store.Set(field.key, value)
+		}
+	})
+	if err != nil {
+		p.err = err.Error()
+		return cmd
+	}
+	severity, message := overlay.Success, "✅ "+field.label+" saved"
+	if value == "" {
+		message = "✅ " + field.label + " reset to default"
+	} else if _, err := os.Stat(p.value(field)); err != nil {
+		severity, message = overlay.Warning, "⚠️  "+field.label+" saved, "+filepath.Clean(p.value(field))+" does not exist yet"
+	}
+	if note := overridden(field.key); note != "" {
+		severity, message = overlay.Warning, "⚠️  "+field.label+" "+note
+	}
+	return tea.Batch(cmd, overlay.Toast(severity, message))
+}
+func (p *PathsPage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if p.editing {
+		switch msg.Type {
+		case tea.KeyEnter:
+			p.editing = false
+			return true, p.save(pathFields[p.selectedIndex], p.input)
+		case tea.KeyEsc:
+			p.editing = false
+		case tea.KeyBackspace:
+			if len(p.input) > 0 {
+				runes := []rune(p.input)
+				p.input = string(runes[:len(runes)-1])
+			}
+		case tea.KeyCtrlU:
+			p.input = ""
+		case tea.KeyRunes, tea.KeySpace:
+			p.input += string(msg.Runes)
+		}
+		return true, nil
+	}
+	switch msg.String() {
+	case "up", "k":
+		if p.selectedIndex > 0 {
+			p.selectedIndex--
+		}
+	case "down", "j":
+		if p.selectedIndex < len(pathFields)-1 {
+			p.selectedIndex++
+		}
+	case "enter":
+		p.editing = true
+		p.err = ""
+		p.input = p.value(pathFields[p.selectedIndex])
+	}
+	return true, nil
+}
+func (p *PathsPage) CapturesInput() bool {
+	return p.editing
+}
+func (p *PathsPage) OnLeave() tea.Cmd {
+	p.editing = false
+	return nil
+}
+func (p *PathsPage) GetTitle() string {
+	return "Paths"
+}
+func (p *PathsPage) GetKeyBindings() []types.KeyBinding {
+	if p.editing {
+		return []types.KeyBinding{
+			{Key: "enter", Description: "Save", Action: "save_path"},
+			{Key: "ctrl+u", Description: "Clear", Action: "clear_path"},
+			{Key: "esc", Description: "Cancel", Action: "cancel_path"},
+		}
+	}
+	return []types.KeyBinding{
+		{Key: "↑/↓", Description: "Select", Action: "select"},
+		{Key: "enter", Description: "Edit", Action: "edit_path"},
+	}
+}
@@ -0,0 +1,79 @@
+package config
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+)
+type ResetPage struct {
+	styles        *PageStyles
+	selectedIndex int
+	err           string
+}
+func NewResetPage() *ResetPage {
+	return &ResetPage{
+		styles:        NewPageStyles(),
+		selectedIndex: 1,
+	}
+}
+func (p *ResetPage) Render(width, height int) string {
+	var content []string
+	content = append(content, p.styles.Title.Render("♻️  Reset"))
+	path, _ := configfile.DefaultPath()
+	content = append(content, p.styles.Description.Render("Remove every setting from "+path+" and go back to the defaults."))
+	content = append(content, p.styles.Description.Render("Project files, DEV_TOOLS_* variables and flags are not touched."))
+	for i, button := range []string{"Reset", "Cancel"} {
+		style := p.styles.Option
+		if i == p.selectedIndex {
+			style = p.styles.OptionFocus
+		}
+		content = append(content, style.Render("  "+button+"  "))
+	}
+	if p.err != "" {
+		content = append(content, "", p.styles.Error.Render("❌ "+p.err))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *ResetPage) reset() tea.Cmd {
+	p.err = ""
+	cmd, err := save(func(store *configfile.Store) {
+		for key := range store.Settings() {
+			store.Unset(key)
+		}
+	})
+	if err != nil {
+		p.err = err.Error()
+		return cmd
+	}
+	p.selectedIndex = 1
+	return tea.Batch(cmd, overlay.Toast(overlay.Success, "✅ Settings reset to defaults"))
+}
+func (p *ResetPage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k", "left", "h":
+		p.selectedIndex = 0
+	case "down", "j", "right", "l":
+		p.selectedIndex = 1
+	case "y":
+		return true, p.reset()
+	case "n":
+		p.selectedIndex = 1
+	case "enter":
+		if p.selectedIndex == 0 {
+			return true, p.reset()
+		}
+		return true, overlay.Toast(overlay.Info, "Nothing was changed")
+	}
+	return true, nil
+}
+func (p *ResetPage) GetTitle() string {
+	return "Reset"
+}
+func (p *ResetPage) GetKeyBindings() []types.KeyBinding {
+	return []types.KeyBinding{
+		{Key: "↑/↓", Description: "Select", Action: "select"},
+		{Key: "enter", Description: "Confirm", Action: "confirm"},
+		{Key: "y/n", Description: "Reset/Cancel", Action: "confirm_reset"},
+	}
+}
@@ -0,0 +1,30 @@
+package config
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+)
+func changed() tea.Msg {
+	return ChangedMsg{}
+}
+func save(update func(store *configfile.Store)) (tea.Cmd, error) {
+	store, err := configfile.OpenScope(configfile.ScopeGlobal)
+	if err != nil {
+		return nil, err
+	}
+	update(store)
+	if err := store.Save(); err != nil {
+		return nil, err
+	}
+	if err := configfile.Refresh(); err != nil {
+		return changed, err
+	}
+	return changed, nil
+}
+func overridden(key string) string {
+	origin := configfile.OriginOf(key)
+	switch origin.Origin {
+	case configfile.OriginUser, configfile.OriginDefault:
+		return ""
+	}
+	return "saved, but overridden by " + origin.String()
+}
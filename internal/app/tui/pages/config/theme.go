@@ -0,0 +1,122 @@
+package config
+import (
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+)
+type ThemePage struct {
+	styles        *PageStyles
+	names         []string
+	selectedIndex int
+	err           string
+}
+func NewThemePage() *ThemePage {
+	p := &ThemePage{
+		styles: NewPageStyles(),
+		names:  theme.Names(),
+	}
+	for i, name := range p.names {
+		if name == configfile.Current().Theme {
+			p.selectedIndex = i
+		}
+	}
+	return p
+}
+func (p *ThemePage) Render(width, height int) string {
+	var content []string
+	content = append(content, p.styles.Title.Render("🎨 Theme"))
+	content = append(content, p.styles.Description.Render("Pick a theme; the preview follows the selection and enter saves it."))
+	for i, name := range p.names {
+		marker := "○"
+		if name == configfile.Current().Theme {
+			marker = "●"
+		}
+		style := p.styles.Option
+		prefix := "  "
+		if i == p.selectedIndex {
+			style = p.styles.OptionFocus
+			prefix = "▶ "
+		}
+		item := style.Render(prefix + marker + " " + name)
+		if i == p.selectedIndex {
+			item = viewport.Focus(item)
+		}
+		content = append(content, item)
+	}
+	content = append(content, "", p.preview(theme.ByName(p.names[p.selectedIndex])))
+	if p.err != "" {
+		content = append(content, "", p.styles.Error.Render("❌ "+p.err))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *ThemePage) preview(t *theme.Theme) string {
+	styles := theme.NewStyles(t)
+	swatches := []struct {
+		name  string
+		color lipgloss.Color
+	}{
+		{"primary", t.Primary},
+		{"secondary", t.Secondary},
+		{"accent", t.Accent},
+		{"muted", t.Muted},
+		{"success", t.Success},
+		{"warning", t.Warning},
+		{"error", t.Error},
+		{"info", t.Info},
+	}
+	var palette []string
+	for _, swatch := range swatches {
+		label := swatch.name
+		if swatch.color == "" {
+			label += " (terminal)"
+		}
+		palette = append(palette, lipgloss.NewStyle().Foreground(swatch.color).Render("■ "+label))
+	}
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.Header.Foreground(t.Foreground).Background(t.Primary).Render("Preview: "+t.Name),
+		strings.Join(palette, "  "),
+		styles.Status.Render("📍 config > theme"),
+	)
+}
+func (p *ThemePage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if p.selectedIndex > 0 {
+			p.selectedIndex--
+		}
+	case "down", "j":
+		if p.selectedIndex < len(p.names)-1 {
+			p.selectedIndex++
+		}
+	case "enter":
+		name := p.names[p.selectedIndex]
+		cmd, err := save(func(store *configfile.Store) {
+			store.Set("theme", name)
+		})
+		p.err = ""
+		if err != nil {
+			p.err = err.Error()
+			return true, cmd
+		}
+		if note := overridden("theme"); note != "" {
+			return true, tea.Batch(cmd, overlay.Toast(overlay.Warning, "⚠️  Theme "+note))
+		}
+		return true, tea.Batch(cmd, overlay.Toast(overlay.Success, "✅ Theme set to "+name))
+	}
+	return true, nil
+}
+func (p *ThemePage) GetTitle() string {
+	return "Theme"
+}
+func (p *ThemePage) GetKeyBindings() []types.KeyBinding {
+	return []types.KeyBinding{
+		{Key: "↑/↓", Description: "Preview", Action: "select"},
+		{Key: "enter", Description: "Save", Action: "save_theme"},
+	}
+}
@@ -3,20 +3,31 @@ package config
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/menu"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
 )
+type ChangedMsg struct{}
 type Page struct {
-	styles *PageStyles
+	styles     *PageStyles
+	menu       *menu.Menu
+	profileErr string
 }
 type PageStyles struct {
 	Title       lipgloss.Style
 	Description lipgloss.Style
 	MenuItem    lipgloss.Style
 	KeyBinding  lipgloss.Style
+	Option      lipgloss.Style
+	OptionFocus lipgloss.Style
+	Error       lipgloss.Style
+	Success     lipgloss.Style
 }
-func NewPage() *Page {
+func NewPage(tree menu.Tree, path string) *Page {
 	return &Page{
 		styles: NewPageStyles(),
+		menu:   menu.New(tree, path),
 	}
 }
 func NewPageStyles() *PageStyles {
@@ -36,69 +47,87 @@ func NewPageStyles() *PageStyles {
 			Foreground(lipgloss.Color("#00D7FF")).
 			Background(lipgloss.Color("#1A1A1A")).
 			Padding(0, 1),
+		Option: lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("#CCCCCC")),
+		OptionFocus: lipgloss.NewStyle().
+			Padding(0, 2).
+			Background(lipgloss.Color("#383838")).
+			Foreground(lipgloss.Color("#FFFFFF")),
+		Error: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")),
+		Success: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#98FB98")),
 	}
 }
+func (p *Page) menuItem(key, label, description string) string {
+	item := lipgloss.JoinHorizontal(
+		lipgloss.Left,
+		p.styles.KeyBinding.Render("["+key+"]"),
+		" "+label+" - ",
+		p.styles.Description.Render(description),
+	)
+	return p.styles.MenuItem.Render(item)
+}
 func (p *Page) Render(width, height int) string {
 	var items []string
 	items = append(items, "⚙️ Configuration")
 	items = append(items, "")
 	items = append(items, p.styles.Description.Render("Configure dev-tools settings and preferences."))
 	items = append(items, "")
-	keyStyle := p.styles.KeyBinding.Render("[t]")
-	item := lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Theme - ",
-		p.styles.Description.Render("Change application theme and colors"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[k]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Keybindings - ",
-		p.styles.Description.Render("Configure keyboard shortcuts"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[p]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Paths - ",
-		p.styles.Description.Render("Configure default project paths"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[r]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Reset - ",
-		p.styles.Description.Render("Reset all settings to defaults"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
+	items = append(items, p.menu.Render()...)
+	profile, _ := configfile.ActiveProfile()
+	if profile == "" {
+		profile = "none"
+	}
+	items = append(items, zone.Mark("config:profile", p.menuItem("o", "Profile ("+profile+")", p.profileHint())))
+	if p.profileErr != "" {
+		items = append(items, p.styles.Error.Render("❌ "+p.profileErr))
+	}
 	return lipgloss.JoinVertical(lipgloss.Left, items...)
 }
+func (p *Page) profileHint() string {
+	if len(configfile.Current().ProfileNames()) == 0 {
+		return "Define profiles in the config file to switch between them"
+	}
+	return "Switch to the next profile for this session"
+}
+func (p *Page) nextProfile() tea.Cmd {
+	names := append([]string{""}, configfile.Current().ProfileNames()...)
+	if len(names) == 1 {
+		return nil
+	}
+	current, _ := configfile.ActiveProfile()
+	next := names[0]
+	for i, name := range names {
+		if name == current {
+			next = names[(i+1)%len(names)]
+			break
+		}
+	}
+	p.profileErr = ""
+	if err := configfile.UseProfile(next); err != nil {
+		p.profileErr = err.Error()
+		return nil
+	}
+	return changed
+}
 func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 	switch msg.String() {
-	case "t":
-		return true, nil
-	case "k":
-		return true, nil
-	case "p":
-		return true, nil
-	case "r":
-		return true, nil
+	case "o":
+		return true, p.nextProfile()
 	}
-	return true, nil
+	return p.menu.HandleInput(msg)
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, id string) tea.Cmd {
+	if id == "config:profile" && msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft {
+		return p.nextProfile()
+	}
+	return p.menu.HandleMouse(msg, id)
 }
 func (p *Page) GetTitle() string {
 	return "Configuration"
 }
 func (p *Page) GetKeyBindings() []types.KeyBinding {
-	return []types.KeyBinding{
-		{Key: "t", Description: "Theme", Action: "configure_theme"},
-		{Key: "k", Description: "Keybindings", Action: "configure_keys"},
-		{Key: "p", Description: "Paths", Action: "configure_paths"},
-		{Key: "r", Description: "Reset", Action: "reset_config"},
-	}
+	return append(p.menu.KeyBindings(), types.KeyBinding{Key: "o", Description: "Profile", Action: "switch_profile"})
 }
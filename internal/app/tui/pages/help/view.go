@@ -1,12 +1,19 @@
 // Package help
 package help
 import (
+	"strings"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
 )
+type Keys interface {
+	GlobalBindings() []types.KeyBinding
+	RouteBindings() []types.RouteBinding
+	KeymapPreset() string
+}
 type Page struct {
 	styles *PageStyles
+	keys   Keys
 }
 type PageStyles struct {
 	Title       lipgloss.Style
@@ -15,9 +22,10 @@ type PageStyles struct {
 	KeyBinding  lipgloss.Style
 	Section     lipgloss.Style
 }
-func NewPage() *Page {
+func NewPage(keys Keys) *Page {
 	return &Page{
 		styles: NewPageStyles(),
+		keys:   keys,
 	}
 }
 func NewPageStyles() *PageStyles {
@@ -49,12 +57,17 @@ func (p *Page) Render(width, height int) string {
 	items = append(items, "")
 	items = append(items, p.styles.Description.Render("Welcome to Dev Tools TUI - your comprehensive development toolkit."))
 	items = append(items, "")
+	items = append(items, p.styles.Section.Render("⌨️  Keys ("+p.keys.KeymapPreset()+" preset):"))
+	for _, kb := range p.keys.GlobalBindings() {
+		items = append(items, p.styles.MenuItem.Render("  ["+kb.Key+"] - "+kb.Description))
+	}
+	items = append(items, "")
 	items = append(items, p.styles.Section.Render("🎯 Navigation:"))
-	items = append(items, p.styles.MenuItem.Render("  [h] - Home"))
-	items = append(items, p.styles.MenuItem.Render("  [l] - Programming Languages"))
-	items = append(items, p.styles.MenuItem.Render("  [c] - Configuration"))
-	items = append(items, p.styles.MenuItem.Render("  [esc] - Go back"))
-	items = append(items, p.styles.MenuItem.Render("  [ctrl+c] - Exit"))
+	for _, binding := range p.keys.RouteBindings() {
+		if strings.Count(binding.Path, "/") == 1 {
+			items = append(items, p.styles.MenuItem.Render("  ["+binding.Key+"] - "+binding.Title))
+		}
+	}
 	items = append(items, "")
 	items = append(items, p.styles.Section.Render("🚀 Features:"))
 	items = append(items, p.styles.MenuItem.Render("  • Project scaffolding with go-blueprint"))
@@ -78,7 +91,7 @@ func (p *Page) GetTitle() string {
 func (p *Page) GetKeyBindings() []types.KeyBinding {
 	return []types.KeyBinding{
 		{Key: "h", Description: "Home", Action: "navigate_home"},
-		{Key: "l", Description: "Languages", Action: "navigate_languages"},
+		{Key: "l", Description: "Languages", Action: "navigate_langs"},
 		{Key: "c", Description: "Configuration", Action: "navigate_config"},
 	}
 }
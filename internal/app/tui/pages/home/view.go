@@ -2,20 +2,21 @@ package home
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/menu"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
 )
 type Page struct {
 	styles *PageStyles
+	menu   *menu.Menu
 }
 type PageStyles struct {
 	Title       lipgloss.Style
 	Description lipgloss.Style
-	MenuItem    lipgloss.Style
-	KeyBinding  lipgloss.Style
 }
-func NewPage() *Page {
+func NewPage(tree menu.Tree, path string) *Page {
 	return &Page{
 		styles: NewPageStyles(),
+		menu:   menu.New(tree, path),
 	}
 }
 func NewPageStyles() *PageStyles {
@@ -27,14 +28,6 @@ func NewPageStyles() *PageStyles {
 		Description: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#CCCCCC")).
 			MarginBottom(1),
-		MenuItem: lipgloss.NewStyle().
-			Padding(0, 2).
-			MarginBottom(1),
-		KeyBinding: lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#00D7FF")).
-			Background(lipgloss.Color("#1A1A1A")).
-			Padding(0, 1),
 	}
 }
 func (p *Page) Render(width, height int) string {
@@ -48,46 +41,23 @@ func (p *Page) Render(width, height int) string {
 	items = append(items, "  • Project scaffolding with go-blueprint")
 	items = append(items, "  • Multi-language development tools")
 	items = append(items, "  • Docker containerization utilities")
+	items = append(items, "  • Project task runner")
 	items = append(items, "  • Configuration management")
 	items = append(items, "")
 	items = append(items, "📋 Choose an option:")
 	items = append(items, "")
-	keyStyle := p.styles.KeyBinding.Render("[l]")
-	item := lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Programming Languages - ",
-		p.styles.Description.Render("Tools for different languages"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[c]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Configuration - ",
-		p.styles.Description.Render("Application settings"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[?]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Help & Documentation - ",
-		p.styles.Description.Render("Usage instructions and help"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
+	items = append(items, p.menu.Render()...)
 	return lipgloss.JoinVertical(lipgloss.Left, items...)
 }
 func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
-	return true, nil
+	return p.menu.HandleInput(msg)
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, zone string) tea.Cmd {
+	return p.menu.HandleMouse(msg, zone)
 }
 func (p *Page) GetTitle() string {
 	return "Dev Tools - Home"
 }
 func (p *Page) GetKeyBindings() []types.KeyBinding {
-	return []types.KeyBinding{
-		{Key: "l", Description: "Languages", Action: "navigate_languages"},
-		{Key: "c", Description: "Configuration", Action: "navigate_config"},
-		{Key: "?", Description: "Help", Action: "navigate_help"},
-	}
+	return p.menu.KeyBindings()
 }
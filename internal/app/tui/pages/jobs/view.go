@@ -0,0 +1,166 @@
+// Package jobs
+package jobs
+import (
+	"fmt"
+	"strings"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+)
+type PageStyles struct {
+	Title       lipgloss.Style
+	Description lipgloss.Style
+	Option      lipgloss.Style
+	OptionFocus lipgloss.Style
+	Output      lipgloss.Style
+}
+func NewPageStyles() *PageStyles {
+	return &PageStyles{
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00D7FF")).
+			MarginBottom(1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#CCCCCC")),
+		Option: lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("#CCCCCC")),
+		OptionFocus: lipgloss.NewStyle().
+			Padding(0, 2).
+			Background(lipgloss.Color("#383838")).
+			Foreground(lipgloss.Color("#FFFFFF")),
+		Output: lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("#CCCCCC")),
+	}
+}
+type Page struct {
+	styles   *PageStyles
+	manager  *jobs.Manager
+	selected int
+	showLog  bool
+}
+func NewPage(manager *jobs.Manager) *Page {
+	return &Page{
+		styles:  NewPageStyles(),
+		manager: manager,
+	}
+}
+func statusIcon(status jobs.Status) string {
+	switch status {
+	case jobs.StatusRunning:
+		return "⏳"
+	case jobs.StatusSucceeded:
+		return "✅"
+	case jobs.StatusCanceled:
+		return "⏹️"
+	}
+	return "❌"
+}
+func (p *Page) current(list []*jobs.Job) (int, *jobs.Job) {
+	for i, job := range list {
+		if job.ID == p.selected {
+			return i, job
+		}
+	}
+	if len(list) == 0 {
+		return 0, nil
+	}
+	p.selected = list[0].ID
+	return 0, list[0]
+}
+func (p *Page) Render(width, height int) string {
+	var content []string
+	content = append(content, p.styles.Title.Render("🧰 Jobs"))
+	list := p.manager.Jobs()
+	if len(list) == 0 {
+		content = append(content, p.styles.Description.Render("No jobs yet. Creating a project or running a task starts one."))
+		return lipgloss.JoinVertical(lipgloss.Left, content...)
+	}
+	content = append(content, p.styles.Description.Render(fmt.Sprintf("%d running, %d total", p.manager.Running(), len(list))))
+	content = append(content, "")
+	index, selected := p.current(list)
+	for i, job := range list {
+		line := fmt.Sprintf("%s #%-3d %-40s %-9s %s", statusIcon(job.Status()), job.ID, job.Title, job.Status(), job.Duration().Round(time.Second))
+		style := p.styles.Option
+		prefix := "  "
+		if i == index {
+			style = p.styles.OptionFocus
+			prefix = "▶ "
+		}
+		item := style.Render(prefix + line)
+		if i == index {
+			item = viewport.Focus(item)
+		}
+		content = append(content, item)
+	}
+	if p.showLog {
+		lines := selected.Lines()
+		if err := selected.Err(); err != nil {
+			lines = append(lines, "❌ "+err.Error())
+		}
+		if len(lines) == 0 {
+			lines = []string{"(no output yet)"}
+		}
+		content = append(content, "", p.styles.Description.Render(fmt.Sprintf("📤 Log of #%d %s:", selected.ID, selected.Title)))
+		content = append(content, p.styles.Output.Render(strings.Join(lines, "\n")))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	list := p.manager.Jobs()
+	index, selected := p.current(list)
+	if selected == nil {
+		return true, nil
+	}
+	switch msg.String() {
+	case "up", "k":
+		if index > 0 {
+			p.selected = list[index-1].ID
+		}
+	case "down", "j":
+		if index < len(list)-1 {
+			p.selected = list[index+1].ID
+		}
+	case "enter", "l":
+		p.showLog = !p.showLog
+	case "c":
+		p.manager.Cancel(selected.ID)
+	case "r":
+		job, ok := p.manager.Retry(selected.ID)
+		if !ok {
+			return true, nil
+		}
+		p.selected = job.ID
+		return true, func() tea.Msg {
+			return jobs.StartedMsg{ID: job.ID, Owner: job.Owner}
+		}
+	}
+	return true, nil
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, id string) tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
+		return nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		p.HandleInput(tea.KeyMsg{Type: tea.KeyUp})
+	case tea.MouseButtonWheelDown:
+		p.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	return nil
+}
+func (p *Page) GetTitle() string {
+	return "Jobs"
+}
+func (p *Page) GetKeyBindings() []types.KeyBinding {
+	return []types.KeyBinding{
+		{Key: "↑/↓", Description: "Select", Action: "select"},
+		{Key: "enter", Description: "View log", Action: "view_log"},
+		{Key: "c", Description: "Cancel", Action: "cancel_job"},
+		{Key: "r", Description: "Retry", Action: "retry_job"},
+	}
+}
@@ -3,12 +3,29 @@ package blueprint
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/process"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
 	"github.com/danielscoffee/dev-tools/internal/pkg/backend/golang"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/recent"
+)
+const (
+	overwriteModal = "blueprint:overwrite"
+	jobOwner       = "blueprint"
+	interactiveID  = "blueprint:interactive"
 )
 type FormStep int
 const (
@@ -21,6 +38,7 @@ const (
 	StepCreating
 	StepComplete
 	StepError
+	StepCanceled
 )
 type Page struct {
 	styles      *PageStyles
@@ -31,6 +49,7 @@ type Page struct {
 	database    string
 	features    []string
 	gitOption   string
+	preset      configfile.Preset
 	input             string
 	cursor            int
 	selectedIndex     int
@@ -38,6 +57,8 @@ type Page struct {
 	error             string
 	creationOutput    []string
 	isCreating        bool
+	jobID             int
+	cancelPending     bool
 	frameworks  []string
 	databases   []string
 	allFeatures []string
@@ -60,9 +81,19 @@ type PageStyles struct {
 	Success       lipgloss.Style
 	Output        lipgloss.Style
 }
+func newBlueprint() *golang.Blueprint {
+	bp := golang.NewBlueprint().WithVersion(configfile.Current().ToolVersion("go-blueprint"))
+	if dir := configfile.Current().Paths.Projects; dir != "" {
+		bp = bp.WithWorkingDir(dir)
+	}
+	if gobin := configfile.Current().Paths.GoBin; gobin != "" {
+		bp = bp.WithGoBin(gobin)
+	}
+	return bp
+}
 func NewPage() *Page {
-	bp := golang.NewBlueprint()
-	return &Page{
+	bp := newBlueprint()
+	page := &Page{
 		styles:            NewPageStyles(),
 		currentStep:       StepProjectName,
 		blueprint:         bp,
@@ -72,7 +103,174 @@ func NewPage() *Page {
 		allFeatures: bp.GetSupportedFeatures(),
 		gitOptions:  []string{"init", "commit", "skip"},
 		gitOption: "commit",
+		preset:    configfile.Current().Presets["default"],
+	}
+	page.applyPreset(page.preset)
+	return page
+}
+func (p *Page) ApplyConfig() {
+	if p.isCreating {
+		return
+	}
+	p.blueprint = newBlueprint()
+	p.preset = configfile.Current().Presets["default"]
+	if p.currentStep == StepProjectName && p.input == "" {
+		p.reset()
+	}
+}
+func (p *Page) UsePreset(name string) error {
+	if p.isCreating {
+		return fmt.Errorf("a project is being created")
+	}
+	preset, ok := configfile.Current().Presets[name]
+	if !ok {
+		return fmt.Errorf("preset %q is not defined", name)
 	}
+	p.preset = preset
+	p.reset()
+	return nil
+}
+func (p *Page) SetParams(params url.Values) error {
+	if p.isCreating {
+		return fmt.Errorf("a project is being created")
+	}
+	if preset := params.Get("preset"); preset != "" {
+		if err := p.UsePreset(preset); err != nil {
+			return err
+		}
+	} else {
+		p.reset()
+	}
+	for key, values := range params {
+		value := values[len(values)-1]
+		switch key {
+		case "preset":
+		case "name":
+			p.input = value
+		case "framework":
+			if !contains(p.frameworks, value) {
+				return fmt.Errorf("unsupported framework %q, use one of: %s", value, strings.Join(p.frameworks, ", "))
+			}
+			p.framework = value
+		case "driver":
+			if !contains(p.databases, value) {
+				return fmt.Errorf("unsupported driver %q, use one of: %s", value, strings.Join(p.databases, ", "))
+			}
+			p.database = value
+		case "features":
+			p.multiSelectStates = make(map[string]bool)
+			for _, value := range values {
+				for _, feature := range strings.Split(value, ",") {
+					if feature = strings.TrimSpace(feature); feature == "" {
+						continue
+					}
+					if !contains(p.allFeatures, feature) {
+						return fmt.Errorf("unsupported feature %q, use one of: %s", feature, strings.Join(p.allFeatures, ", "))
+					}
+					p.multiSelectStates[feature] = true
+				}
+			}
+		case "git":
+			if !contains(p.gitOptions, value) {
+				return fmt.Errorf("unsupported git option %q, use one of: %s", value, strings.Join(p.gitOptions, ", "))
+			}
+			p.gitOption = value
+		default:
+			return fmt.Errorf("unknown parameter %q, use name, preset, framework, driver, features or git", key)
+		}
+	}
+	return nil
+}
+func (p *Page) applyPreset(preset configfile.Preset) {
+	if preset.Framework != "" {
+		p.framework = preset.Framework
+	}
+	if preset.Driver != "" {
+		p.database = preset.Driver
+	}
+	for _, feature := range preset.Features {
+		p.multiSelectStates[feature] = true
+	}
+	if preset.Git != "" {
+		p.gitOption = preset.Git
+	}
+}
+func (p *Page) Draft() url.Values {
+	if p.isCreating || p.currentStep == StepComplete || (p.currentStep == StepProjectName && p.input == "") {
+		return nil
+	}
+	step := min(p.currentStep, StepConfirm)
+	var features []string
+	for _, feature := range p.allFeatures {
+		if p.multiSelectStates[feature] {
+			features = append(features, feature)
+		}
+	}
+	draft := url.Values{
+		"step":     {strconv.Itoa(int(step))},
+		"name":     {p.input},
+		"features": {strings.Join(features, ",")},
+		"git":      {p.gitOption},
+	}
+	if p.framework != "" {
+		draft.Set("framework", p.framework)
+	}
+	if p.database != "" {
+		draft.Set("driver", p.database)
+	}
+	return draft
+}
+func (p *Page) RestoreDraft(draft url.Values) error {
+	params := url.Values{}
+	for key, values := range draft {
+		params[key] = values
+	}
+	step, _ := strconv.Atoi(params.Get("step"))
+	params.Del("step")
+	if err := p.SetParams(params); err != nil {
+		return err
+	}
+	if step < int(StepProjectName) || step > int(StepConfirm) || p.input == "" {
+		step = int(StepProjectName)
+	}
+	p.currentStep = FormStep(step)
+	p.selectedIndex = 0
+	if p.currentStep > StepProjectName {
+		p.projectName = p.input
+	}
+	if p.currentStep > StepFeatures {
+		p.features = []string{}
+		for _, feature := range p.allFeatures {
+			if p.multiSelectStates[feature] {
+				p.features = append(p.features, feature)
+			}
+		}
+	}
+	switch p.currentStep {
+	case StepFramework:
+		p.selectedIndex = indexOf(p.frameworks, p.framework)
+	case StepDatabase:
+		p.selectedIndex = indexOf(append([]string{"none"}, p.databases...), p.database)
+	case StepGitOption:
+		p.selectedIndex = indexOf(p.gitOptions, p.gitOption)
+	}
+	return nil
+}
+func contains(options []string, value string) bool {
+	for _, option := range options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+func indexOf(options []string, value string) int {
+	for i, option := range options {
+		if option == value {
+			return i
+		}
+	}
+	return 0
 }
 func NewPageStyles() *PageStyles {
 	return &PageStyles{
@@ -172,8 +370,14 @@ func (p *Page) Render(width, height int) string {
 		content = append(content, p.renderCompleteStep()...)
 	case StepError:
 		content = append(content, p.renderErrorStep()...)
+	case StepCanceled:
+		content = append(content, p.renderCanceledStep()...)
 	}
 	content = append(content, "")
+	if p.isCreating {
+		content = append(content, p.styles.Description.Render("Navigation: [esc/ctrl+c] cancel project creation"))
+		return lipgloss.JoinVertical(lipgloss.Left, content...)
+	}
 	content = append(content, p.styles.Description.Render("Navigation: [enter] next/confirm • [esc] back • [ctrl+c] exit"))
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
@@ -211,7 +415,7 @@ func (p *Page) renderFrameworkStep() []string {
 			style = p.styles.Selected
 			prefix = "✓ "
 		}
-		content = append(content, style.Render(prefix+framework))
+		content = append(content, p.option(i, style.Render(prefix+framework)))
 	}
 	return content
 }
@@ -232,7 +436,7 @@ func (p *Page) renderDatabaseStep() []string {
 			style = p.styles.Selected
 			prefix = "✓ "
 		}
-		content = append(content, style.Render(prefix+database))
+		content = append(content, p.option(i, style.Render(prefix+database)))
 	}
 	return content
 }
@@ -253,7 +457,7 @@ func (p *Page) renderFeaturesStep() []string {
 		if i == p.selectedIndex {
 			prefix = "▶" + prefix[1:]
 		}
-		content = append(content, style.Render(prefix+feature))
+		content = append(content, p.option(i, style.Render(prefix+feature)))
 	}
 	content = append(content, "")
 	selectedFeatures := []string{}
@@ -292,7 +496,7 @@ func (p *Page) renderGitOptionStep() []string {
 			style = p.styles.Selected
 			prefix = "✓ "
 		}
-		content = append(content, style.Render(prefix+option+description))
+		content = append(content, p.option(i, style.Render(prefix+option+description)))
 	}
 	return content
 }
@@ -301,6 +505,10 @@ func (p *Page) renderConfirmStep() []string {
 	content = append(content, p.styles.FormLabel.Render("✅ Confirm Project Creation"))
 	content = append(content, "")
 	content = append(content, p.styles.Description.Render("Project Name: "+p.projectName))
+	module := configfile.Current().ModulePath(p.projectName)
+	if module != p.projectName {
+		content = append(content, p.styles.Description.Render("Module: "+module))
+	}
 	content = append(content, p.styles.Description.Render("Framework: "+p.framework))
 	if p.database != "" && p.database != "none" {
 		content = append(content, p.styles.Description.Render("Database: "+p.database))
@@ -309,18 +517,21 @@ func (p *Page) renderConfirmStep() []string {
 		content = append(content, p.styles.Description.Render("Features: "+strings.Join(p.features, ", ")))
 	}
 	content = append(content, p.styles.Description.Render("Git: "+p.gitOption))
+	if dir := p.blueprint.WorkingDir(); dir != "" && dir != "." {
+		content = append(content, p.styles.Description.Render("Directory: "+dir))
+	}
 	content = append(content, "")
-	command := p.blueprint.GetCommandString(p.projectName, p.framework, p.database, p.gitOption, p.features)
+	command := p.blueprint.GetCommandString(module, p.framework, p.database, p.gitOption, p.features)
 	content = append(content, p.styles.FormLabel.Render("Command to execute:"))
 	content = append(content, p.styles.Output.Render(command))
 	content = append(content, "")
-	buttons := []string{"Create Project", "Cancel"}
+	buttons := []string{"Create Project", "Open in go-blueprint", "Cancel"}
 	for i, button := range buttons {
 		style := p.styles.Button
 		if i == p.selectedIndex {
 			style = p.styles.ButtonFocus
 		}
-		content = append(content, style.Render("  "+button+"  "))
+		content = append(content, p.option(i, style.Render("  "+button+"  ")))
 	}
 	return content
 }
@@ -329,6 +540,9 @@ func (p *Page) renderCreatingStep() []string {
 	content = append(content, p.styles.FormLabel.Render("🚧 Creating Project..."))
 	content = append(content, "")
 	content = append(content, p.styles.Description.Render("Please wait while your project is being created."))
+	if p.jobID != 0 {
+		content = append(content, p.styles.Description.Render(fmt.Sprintf("It runs as job #%d: you can leave this page and follow it under Jobs.", p.jobID)))
+	}
 	content = append(content, "")
 	if len(p.creationOutput) > 0 {
 		content = append(content, p.styles.Output.Render(strings.Join(p.creationOutput, "\n")))
@@ -344,7 +558,7 @@ func (p *Page) renderCompleteStep() []string {
 	content = append(content, p.styles.Description.Render("Next steps:"))
 	content = append(content, p.styles.Description.Render("  1. cd "+p.projectName))
 	content = append(content, p.styles.Description.Render("  2. go mod tidy"))
-	content = append(content, p.styles.Description.Render("  3. go run main.go"))
+	content = append(content, p.styles.Description.Render("  3. dev-tools golang watch ./cmd/api (rebuilds and restarts on change)"))
 	content = append(content, "")
 	style := p.styles.ButtonFocus
 	content = append(content, style.Render("  Create Another Project  "))
@@ -362,6 +576,17 @@ func (p *Page) renderErrorStep() []string {
 	content = append(content, style.Render("  Try Again  "))
 	return content
 }
+func (p *Page) renderCanceledStep() []string {
+	var content []string
+	content = append(content, p.styles.FormLabel.Render("⏹️  Project Creation Canceled"))
+	content = append(content, "")
+	content = append(content, p.styles.Description.Render("go-blueprint was stopped before '"+p.projectName+"' was finished."))
+	content = append(content, p.styles.Description.Render("Remove a partially created directory before trying again."))
+	content = append(content, "")
+	style := p.styles.ButtonFocus
+	content = append(content, style.Render("  Try Again  "))
+	return content
+}
 type CreateProjectCmd struct {
 	projectName string
 	framework   string
@@ -370,65 +595,59 @@ type CreateProjectCmd struct {
 	gitOption   string
 	blueprint   *golang.Blueprint
 }
-type ProjectCreatedMsg struct {
-	output string
-}
-type ProjectErrorMsg struct {
-	error  string
-	output string
-}
 type ProjectDebugMsg struct {
 	message string
 }
-func (c CreateProjectCmd) Execute() tea.Msg {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+func logLines(job *jobs.Job, output string) {
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		job.Log(line)
+	}
+}
+func (c CreateProjectCmd) Run(ctx context.Context, job *jobs.Job) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
-	var fullOutput strings.Builder
 	if !c.blueprint.IsInstalled() {
-		fullOutput.WriteString("📦 Installing go-blueprint CLI...\n")
-		if err := c.blueprint.InstallCLI(ctx); err != nil {
-			return ProjectErrorMsg{
-				error:  fmt.Sprintf("Failed to install go-blueprint: %v", err),
-				output: fullOutput.String(),
-			}
+		job.Log("📦 Installing go-blueprint CLI...")
+		output, err := c.blueprint.InstallCLIWithOutput(ctx)
+		if output != "" {
+			logLines(job, output)
 		}
-		fullOutput.WriteString("✅ go-blueprint CLI installed successfully\n\n")
+		if err != nil {
+			return fmt.Errorf("failed to install go-blueprint: %w", err)
+		}
+		job.Log("✅ go-blueprint CLI installed successfully")
+		job.Log("")
 	}
 	database := c.database
 	if database == "" {
 		database = "none"
 	}
-	fullOutput.WriteString("🚀 Creating project...\n")
+	job.Log("🚀 Creating project...")
 	if len(c.features) > 0 {
-		fullOutput.WriteString("🎨 Features: " + strings.Join(c.features, ", ") + "\n")
+		job.Log("🎨 Features: " + strings.Join(c.features, ", "))
 	}
 	if c.gitOption != "" && c.gitOption != "skip" {
-		fullOutput.WriteString("📚 Git: " + c.gitOption + "\n")
+		job.Log("📚 Git: " + c.gitOption)
 	}
 	gitOpt := c.gitOption
 	if gitOpt == "skip" {
 		gitOpt = ""
 	}
 	command := c.blueprint.GetCommandString(c.projectName, c.framework, database, gitOpt, c.features)
-	fullOutput.WriteString("📋 Executing: " + command + "\n\n")
-	fullOutput.WriteString("⚡ Running go-blueprint...\n")
+	job.Log("📋 Executing: " + command)
+	job.Log("")
+	job.Log("⚡ Running go-blueprint...")
 	output, err := c.blueprint.CreateProjectWithOutput(ctx, c.projectName, c.framework, database, gitOpt, c.features)
 	if output != "" {
-		fullOutput.WriteString("📤 Command Output:\n")
-		fullOutput.WriteString(output)
-		fullOutput.WriteString("\n")
+		job.Log("📤 Command Output:")
+		logLines(job, output)
 	}
 	if err != nil {
-		fullOutput.WriteString("❌ Error occurred: " + err.Error() + "\n")
-		return ProjectErrorMsg{
-			error:  fmt.Sprintf("Failed to create project: %v", err),
-			output: fullOutput.String(),
-		}
-	}
-	fullOutput.WriteString("🎉 Project created successfully!")
-	return ProjectCreatedMsg{
-		output: fullOutput.String(),
+		return fmt.Errorf("failed to create project: %w", err)
 	}
+	recent.Add(filepath.Join(c.blueprint.WorkingDir(), path.Base(c.projectName)))
+	job.Log("🎉 Project created successfully!")
+	return nil
 }
 func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 	if msg.String() == "esc" {
@@ -460,7 +679,7 @@ func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 		case StepComplete:
 			p.reset()
 			return true, nil
-		case StepError:
+		case StepError, StepCanceled:
 			p.currentStep = StepConfirm
 			p.selectedIndex = 0
 			return true, nil
@@ -484,7 +703,7 @@ func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 		return p.handleCreatingInput(msg)
 	case StepComplete:
 		return p.handleCompleteInput(msg)
-	case StepError:
+	case StepError, StepCanceled:
 		return p.handleErrorInput(msg)
 	}
 	return true, nil
@@ -495,7 +714,7 @@ func (p *Page) handleProjectNameInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 		if p.input != "" {
 			p.projectName = p.input
 			p.currentStep = StepFramework
-			p.selectedIndex = 0
+			p.selectedIndex = indexOf(p.frameworks, p.framework)
 		}
 		return true, nil
 	case "backspace":
@@ -525,7 +744,7 @@ func (p *Page) handleFrameworkInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 	case "enter":
 		p.framework = p.frameworks[p.selectedIndex]
 		p.currentStep = StepDatabase
-		p.selectedIndex = 0
+		p.selectedIndex = indexOf(append([]string{"none"}, p.databases...), p.database)
 		return true, nil
 	}
 	return true, nil
@@ -575,7 +794,7 @@ func (p *Page) handleFeaturesInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 			}
 		}
 		p.currentStep = StepGitOption
-		p.selectedIndex = 1
+		p.selectedIndex = indexOf(p.gitOptions, p.gitOption)
 		return true, nil
 	}
 	return true, nil
@@ -608,29 +827,50 @@ func (p *Page) handleConfirmInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 		}
 		return true, nil
 	case "down", "j":
-		if p.selectedIndex < 1 {
+		if p.selectedIndex < 2 {
 			p.selectedIndex++
 		}
 		return true, nil
 	case "enter":
-		if p.selectedIndex == 0 {
-			p.currentStep = StepCreating
-			p.isCreating = true
-			return true, CreateProjectCmd{
-				projectName: p.projectName,
-				framework:   p.framework,
-				database:    p.database,
-				features:    p.features,
-				gitOption:   p.gitOption,
-				blueprint:   p.blueprint,
-			}.Execute
-		} else {
+		switch p.selectedIndex {
+		case 0:
+			if dir := p.projectDir(); exists(dir) {
+				return true, overlay.Confirm(overwriteModal, "Overwrite existing directory?", dir+" already exists. go-blueprint will write the new project into it.")
+			}
+			return true, p.create()
+		case 1:
+			return true, p.interactive()
+		default:
 			return false, nil
 		}
-		return true, nil
 	}
 	return true, nil
 }
+func (p *Page) projectDir() string {
+	return filepath.Join(p.blueprint.WorkingDir(), path.Base(p.projectName))
+}
+func exists(dir string) bool {
+	_, err := os.Stat(dir)
+	return err == nil
+}
+func (p *Page) create() tea.Cmd {
+	p.currentStep = StepCreating
+	p.isCreating = true
+	p.jobID = 0
+	p.cancelPending = false
+	p.creationOutput = nil
+	return jobs.Run(jobOwner, "Create "+p.projectName, CreateProjectCmd{
+		projectName: configfile.Current().ModulePath(p.projectName),
+		framework:   p.framework,
+		database:    p.database,
+		features:    p.features,
+		gitOption:   p.gitOption,
+		blueprint:   p.blueprint,
+	}.Run)
+}
+func (p *Page) interactive() tea.Cmd {
+	return process.Exec(interactiveID, p.blueprint.Command(context.Background(), "create", "--name", configfile.Current().ModulePath(p.projectName)))
+}
 func (p *Page) handleCreatingInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 	return true, nil
 }
@@ -653,20 +893,112 @@ func (p *Page) handleErrorInput(msg tea.KeyMsg) (bool, tea.Cmd) {
 }
 func (p *Page) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
-	case ProjectCreatedMsg:
+	case overlay.ResultMsg:
+		if msg.ID == overwriteModal && msg.OK && p.currentStep == StepConfirm {
+			return p.create()
+		}
+		return nil
+	case process.DoneMsg:
+		if msg.ID != interactiveID || p.currentStep != StepConfirm {
+			return nil
+		}
+		if !msg.Success() {
+			p.currentStep = StepError
+			p.error = fmt.Sprintf("%s exited with status %d", msg.Command, msg.ExitCode)
+			if msg.ExitCode < 0 {
+				p.error = msg.Err.Error()
+			}
+			return nil
+		}
+		if dir := p.projectDir(); exists(dir) {
+			recent.Add(dir)
+		}
 		p.currentStep = StepComplete
+	case jobs.StartedMsg:
+		if msg.Owner == jobOwner && p.isCreating && p.jobID == 0 {
+			p.jobID = msg.ID
+			if p.cancelPending {
+				p.cancelPending = false
+				return jobs.Cancel(msg.ID)
+			}
+		}
+	case jobs.LogMsg:
+		if msg.ID == p.jobID {
+			p.creationOutput = append(p.creationOutput, msg.Line)
+		}
+	case jobs.DoneMsg:
+		if msg.ID != p.jobID {
+			return nil
+		}
 		p.isCreating = false
-		p.creationOutput = strings.Split(msg.output, "\n")
-		return nil
-	case ProjectErrorMsg:
+		switch msg.Status {
+		case jobs.StatusSucceeded:
+			p.currentStep = StepComplete
+			return nil
+		case jobs.StatusCanceled:
+			p.currentStep = StepCanceled
+			return nil
+		}
 		p.currentStep = StepError
-		p.error = msg.error
-		p.isCreating = false
-		p.creationOutput = strings.Split(msg.output, "\n")
+		p.error = string(msg.Status)
+		if msg.Err != nil {
+			p.error = msg.Err.Error()
+		}
+	}
+	return nil
+}
+func optionZone(i int) string {
+	return fmt.Sprintf("blueprint:option:%d", i)
+}
+func (p *Page) option(i int, content string) string {
+	content = zone.Mark(optionZone(i), content)
+	if i == p.selectedIndex {
+		return viewport.Focus(content)
+	}
+	return content
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, id string) tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
 		return nil
 	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		_, cmd := p.HandleInput(tea.KeyMsg{Type: tea.KeyUp})
+		return cmd
+	case tea.MouseButtonWheelDown:
+		_, cmd := p.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+		return cmd
+	case tea.MouseButtonLeft:
+		var i int
+		if _, err := fmt.Sscanf(id, "blueprint:option:%d", &i); err != nil {
+			return nil
+		}
+		p.selectedIndex = i
+		if p.currentStep == StepFeatures {
+			_, cmd := p.HandleInput(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+			return cmd
+		}
+		_, cmd := p.HandleInput(tea.KeyMsg{Type: tea.KeyEnter})
+		return cmd
+	}
 	return nil
 }
+func (p *Page) Busy() string {
+	if p.isCreating {
+		return "project creation"
+	}
+	return ""
+}
+func (p *Page) Cancel() tea.Cmd {
+	if p.jobID == 0 {
+		p.cancelPending = true
+		return nil
+	}
+	return jobs.Cancel(p.jobID)
+}
+func (p *Page) CapturesInput() bool {
+	return p.currentStep == StepProjectName
+}
 func (p *Page) reset() {
 	p.currentStep = StepProjectName
 	p.projectName = ""
@@ -680,6 +1012,9 @@ func (p *Page) reset() {
 	p.error = ""
 	p.creationOutput = []string{}
 	p.isCreating = false
+	p.jobID = 0
+	p.cancelPending = false
+	p.applyPreset(p.preset)
 }
 func (p *Page) GetTitle() string {
 	return "Go Blueprint Creator"
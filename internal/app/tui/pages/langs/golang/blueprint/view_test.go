@@ -0,0 +1,36 @@
+package blueprint
+import (
+	"net/url"
+	"testing"
+)
+func TestSetParams(t *testing.T) {
+	page := NewPage()
+	err := page.SetParams(url.Values{
+		"name":      {"api"},
+		"framework": {"gin"},
+		"driver":    {"postgres"},
+		"features":  {"htmx, docker", "githubaction"},
+		"git":       {"skip"},
+	})
+	if err != nil {
+		t.Fatalf("SetParams() error = %v", err)
+	}
+	if page.input != "api" || page.framework != "gin" || page.database != "postgres" || page.gitOption != "skip" {
+		t.Errorf("SetParams() left name=%q framework=%q driver=%q git=%q", page.input, page.framework, page.database, page.gitOption)
+	}
+	if len(page.multiSelectStates) != 3 || !page.multiSelectStates["docker"] {
+		t.Errorf("features = %v, want htmx, docker and githubaction", page.multiSelectStates)
+	}
+	for _, params := range []url.Values{
+		{"framework": {"rails"}},
+		{"driver": {"oracle"}},
+		{"features": {"htmx,blockchain"}},
+		{"git": {"push"}},
+		{"colour": {"red"}},
+		{"preset": {"missing"}},
+	} {
+		if err := page.SetParams(params); err == nil {
+			t.Errorf("SetParams(%v) should fail", params)
+		}
+	}
+}
@@ -3,21 +3,22 @@ package golang
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/menu"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
 )
 type Page struct {
 	styles *PageStyles
+	menu   *menu.Menu
 }
 type PageStyles struct {
 	Title       lipgloss.Style
 	Description lipgloss.Style
-	MenuItem    lipgloss.Style
-	KeyBinding  lipgloss.Style
 	Feature     lipgloss.Style
 }
-func NewPage() *Page {
+func NewPage(tree menu.Tree, path string) *Page {
 	return &Page{
 		styles: NewPageStyles(),
+		menu:   menu.New(tree, path),
 	}
 }
 func NewPageStyles() *PageStyles {
@@ -29,14 +30,6 @@ func NewPageStyles() *PageStyles {
 		Description: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#CCCCCC")).
 			MarginBottom(1),
-		MenuItem: lipgloss.NewStyle().
-			Padding(0, 2).
-			MarginBottom(1),
-		KeyBinding: lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#00D7FF")).
-			Background(lipgloss.Color("#1A1A1A")).
-			Padding(0, 1),
 		Feature: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#98FB98")).
 			Padding(0, 2),
@@ -53,51 +46,18 @@ func (p *Page) Render(width, height int) string {
 	items = append(items, p.styles.Feature.Render("  • CLI applications"))
 	items = append(items, p.styles.Feature.Render("  • Web applications with Fiber/Gin"))
 	items = append(items, "")
-	keyStyle := p.styles.KeyBinding.Render("[b]")
-	item := lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Blueprint - ",
-		p.styles.Description.Render("Create Go projects with go-blueprint"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[m]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Modules - ",
-		p.styles.Description.Render("Manage go.mod and dependencies"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[t]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Tests - ",
-		p.styles.Description.Render("Run tests and benchmarks"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
-	keyStyle = p.styles.KeyBinding.Render("[f]")
-	item = lipgloss.JoinHorizontal(
-		lipgloss.Left,
-		keyStyle,
-		" Format - ",
-		p.styles.Description.Render("Format code with gofmt/goimports"),
-	)
-	items = append(items, p.styles.MenuItem.Render(item))
+	items = append(items, p.menu.Render()...)
 	return lipgloss.JoinVertical(lipgloss.Left, items...)
 }
 func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
-	return true, nil
+	return p.menu.HandleInput(msg)
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, zone string) tea.Cmd {
+	return p.menu.HandleMouse(msg, zone)
 }
 func (p *Page) GetTitle() string {
 	return "Go/Golang Tools"
 }
 func (p *Page) GetKeyBindings() []types.KeyBinding {
-	return []types.KeyBinding{
-		{Key: "b", Description: "Blueprint", Action: "navigate_blueprint"},
-		{Key: "m", Description: "Modules", Action: "manage_modules"},
-		{Key: "t", Description: "Tests", Action: "run_tests"},
-		{Key: "f", Description: "Format", Action: "format_code"},
-	}
+	return p.menu.KeyBindings()
 }
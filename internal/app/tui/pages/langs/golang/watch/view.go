@@ -0,0 +1,253 @@
+// Package watch
+package watch
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/watch"
+)
+const (
+	maxLogLines = 500
+	jobOwner    = "watch"
+)
+type EventMsg struct {
+	JobID int
+	Event watch.Event
+}
+type PageStyles struct {
+	Title       lipgloss.Style
+	Description lipgloss.Style
+	Status      lipgloss.Style
+	Output      lipgloss.Style
+	Error       lipgloss.Style
+}
+func NewPageStyles() *PageStyles {
+	return &PageStyles{
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00D7FF")).
+			MarginBottom(1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#CCCCCC")),
+		Status: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#98FB98")),
+		Output: lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("#CCCCCC")),
+		Error: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Background(lipgloss.Color("#2D1B1B")).
+			Padding(0, 2).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("#FF6B6B")),
+	}
+}
+type Page struct {
+	styles     *PageStyles
+	watcher       *watch.Watcher
+	running       bool
+	jobID         int
+	cancelPending bool
+	status        string
+	buildError    string
+	log           []string
+	scroll        int
+	dir           string
+}
+func NewPage() *Page {
+	return &Page{
+		styles: NewPageStyles(),
+		status: "Stopped",
+	}
+}
+func (p *Page) Render(width, height int) string {
+	var content []string
+	content = append(content, p.styles.Title.Render("👀 Watch & Reload"))
+	cfg := p.config().WithDefaults()
+	if p.watcher != nil {
+		cfg = p.watcher.Config()
+	}
+	content = append(content, p.styles.Description.Render("Directory: "+cfg.Dir))
+	content = append(content, p.styles.Description.Render("Package:   "+cfg.Package))
+	content = append(content, p.styles.Description.Render("Include:   "+strings.Join(cfg.Include, ", ")))
+	if cfg.EnvFile != "" {
+		content = append(content, p.styles.Description.Render("Env file:  "+cfg.EnvFile))
+	}
+	content = append(content, "")
+	content = append(content, p.styles.Status.Render("Status: "+p.status))
+	if p.buildError != "" {
+		content = append(content, "", p.styles.Error.Render(strings.TrimRight(p.buildError, "\n")))
+	}
+	if len(p.log) > 0 {
+		content = append(content, "", p.styles.Description.Render("📤 Output:"))
+		lines := p.log[:len(p.log)-p.scroll]
+		if limit := max(5, height-10-strings.Count(p.buildError, "\n")); len(lines) > limit {
+			lines = lines[len(lines)-limit:]
+		}
+		content = append(content, p.styles.Output.Render(strings.Join(lines, "\n")))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *Page) start() tea.Cmd {
+	p.running = true
+	p.jobID = 0
+	p.cancelPending = false
+	p.log = nil
+	p.scroll = 0
+	p.buildError = ""
+	p.status = "Starting"
+	watcher := watch.New(p.config())
+	p.watcher = watcher
+	return jobs.Run(jobOwner, "Watch "+watcher.Config().Dir, func(ctx context.Context, job *jobs.Job) error {
+		watcher.WithHandler(func(event watch.Event) {
+			job.Send(EventMsg{JobID: job.ID, Event: event})
+			if line := eventLine(event); line != "" {
+				job.Log(line)
+			}
+		})
+		return watcher.Run(ctx)
+	})
+}
+func (p *Page) config() watch.Config {
+	cfg := configfile.Current().Watch
+	if p.dir != "" && !filepath.IsAbs(cfg.Dir) {
+		cfg.Dir = filepath.Join(p.dir, cfg.Dir)
+	}
+	return cfg
+}
+func (p *Page) OpenProject(dir string) {
+	p.dir = dir
+}
+func (p *Page) stop() tea.Cmd {
+	p.status = "Stopping"
+	if p.jobID == 0 {
+		p.cancelPending = true
+		return nil
+	}
+	return jobs.Cancel(p.jobID)
+}
+func eventLine(event watch.Event) string {
+	switch event.Type {
+	case watch.EventChange:
+		return "🔄 " + strings.Join(event.Files, ", ")
+	case watch.EventStop:
+		return fmt.Sprintf("🛑 Stopped pid %d", event.PID)
+	case watch.EventOutput:
+		return event.Line
+	case watch.EventError:
+		return fmt.Sprintf("❌ %v", event.Err)
+	}
+	return ""
+}
+func (p *Page) appendLog(line string) {
+	p.log = append(p.log, line)
+	if len(p.log) > maxLogLines {
+		p.log = p.log[len(p.log)-maxLogLines:]
+	}
+	if p.scroll > 0 {
+		p.scroll = min(p.scroll+1, len(p.log)-1)
+	}
+}
+func (p *Page) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case jobs.StartedMsg:
+		if msg.Owner != jobOwner || (p.running && p.jobID != 0) {
+			return nil
+		}
+		if !p.running {
+			p.running = true
+			p.log = nil
+			p.scroll = 0
+			p.buildError = ""
+			p.status = "Starting"
+		}
+		p.jobID = msg.ID
+		if p.cancelPending {
+			p.cancelPending = false
+			return jobs.Cancel(msg.ID)
+		}
+	case EventMsg:
+		if msg.JobID != p.jobID {
+			return nil
+		}
+		event := msg.Event
+		switch event.Type {
+		case watch.EventBuild:
+			p.status = "Building"
+		case watch.EventBuildFailed:
+			p.status = "Build failed"
+			p.buildError = event.Output
+			if p.buildError == "" && event.Err != nil {
+				p.buildError = event.Err.Error()
+			}
+		case watch.EventStart:
+			p.status = fmt.Sprintf("Running (pid %d)", event.PID)
+			p.buildError = ""
+		case watch.EventExit:
+			p.status = fmt.Sprintf("Exited: %v", event.Err)
+		}
+		if line := eventLine(event); line != "" {
+			p.appendLog(line)
+		}
+	case jobs.DoneMsg:
+		if msg.ID != p.jobID {
+			return nil
+		}
+		p.running = false
+		p.jobID = 0
+		p.status = "Stopped"
+		if msg.Err != nil && msg.Status != jobs.StatusCanceled {
+			p.status = "Stopped: " + msg.Err.Error()
+		}
+	}
+	return nil
+}
+func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "enter", "s":
+		if p.status == "Stopping" {
+			return true, nil
+		}
+		if p.running {
+			return true, p.stop()
+		}
+		return true, p.start()
+	case "r":
+		if p.running && p.watcher != nil {
+			p.watcher.Rebuild()
+		}
+	case "x":
+		p.log = nil
+		p.scroll = 0
+	}
+	return true, nil
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, id string) tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
+		return nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		p.scroll = min(p.scroll+3, max(0, len(p.log)-1))
+	case tea.MouseButtonWheelDown:
+		p.scroll = max(p.scroll-3, 0)
+	}
+	return nil
+}
+func (p *Page) GetTitle() string {
+	return "Watch & Reload"
+}
+func (p *Page) GetKeyBindings() []types.KeyBinding {
+	return []types.KeyBinding{
+		{Key: "s", Description: "Start/stop", Action: "toggle_watch"},
+		{Key: "r", Description: "Rebuild", Action: "rebuild"},
+		{Key: "x", Description: "Clear log", Action: "clear_log"},
+	}
+}
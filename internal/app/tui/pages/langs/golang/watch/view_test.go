@@ -0,0 +1,31 @@
+package watch
+import (
+	"testing"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/pkg/watch"
+)
+func TestWatchRunsAsJob(t *testing.T) {
+	p := NewPage()
+	p.OpenProject(t.TempDir())
+	cmd := p.start()
+	start, ok := cmd().(jobs.StartMsg)
+	if !ok || start.Owner != jobOwner {
+		t.Fatalf("start() = %#v, want a jobs.StartMsg for %s", start, jobOwner)
+	}
+	if cmd := p.stop(); cmd != nil || !p.cancelPending {
+		t.Fatalf("stop() before the job started = %v, want it deferred", cmd)
+	}
+	cmd = p.Update(jobs.StartedMsg{ID: 7, Owner: jobOwner})
+	if msg, ok := cmd().(jobs.CancelMsg); !ok || msg.ID != 7 {
+		t.Fatalf("StartedMsg = %#v, want the deferred jobs.CancelMsg{ID: 7}", msg)
+	}
+	p.Update(EventMsg{JobID: 6, Event: watch.Event{Type: watch.EventStart, PID: 1}})
+	p.Update(EventMsg{JobID: 7, Event: watch.Event{Type: watch.EventOutput, Line: "listening"}})
+	if p.status != "Stopping" || len(p.log) != 1 || p.log[0] != "listening" {
+		t.Errorf("status %q, log %v, want only job 7's output", p.status, p.log)
+	}
+	p.Update(jobs.DoneMsg{ID: 7, Owner: jobOwner, Status: jobs.StatusCanceled})
+	if p.running || p.status != "Stopped" {
+		t.Errorf("after DoneMsg running = %v, status = %q", p.running, p.status)
+	}
+}
@@ -0,0 +1,131 @@
+// Package menu
+package menu
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
+)
+type Tree interface {
+	Children(path string) []types.MenuEntry
+}
+type Styles struct {
+	MenuItem    lipgloss.Style
+	MenuFocus   lipgloss.Style
+	KeyBinding  lipgloss.Style
+	Description lipgloss.Style
+	Blocked     lipgloss.Style
+}
+type Menu struct {
+	tree          Tree
+	path          string
+	styles        *Styles
+	selectedIndex int
+}
+func New(tree Tree, path string) *Menu {
+	return &Menu{
+		tree:   tree,
+		path:   path,
+		styles: NewStyles(),
+	}
+}
+func NewStyles() *Styles {
+	return &Styles{
+		MenuItem: lipgloss.NewStyle().
+			Padding(0, 2).
+			MarginBottom(1),
+		MenuFocus: lipgloss.NewStyle().
+			Padding(0, 2).
+			MarginBottom(1).
+			Background(lipgloss.Color("#383838")),
+		KeyBinding: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00D7FF")).
+			Background(lipgloss.Color("#1A1A1A")).
+			Padding(0, 1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#CCCCCC")),
+		Blocked: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")),
+	}
+}
+func (m *Menu) Entries() []types.MenuEntry {
+	return m.tree.Children(m.path)
+}
+func (m *Menu) Render() []string {
+	entries := m.Entries()
+	var items []string
+	for i, entry := range entries {
+		description := m.styles.Description.Render(entry.Description)
+		if entry.Blocked != "" {
+			description = m.styles.Blocked.Render("🔒 " + entry.Blocked)
+		}
+		item := lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			m.styles.KeyBinding.Render("["+entry.Key+"]"),
+			" "+entry.Title+" - ",
+			description,
+		)
+		item = zone.Mark("menu:"+entry.Path, item)
+		style := m.styles.MenuItem
+		if i == m.selectedIndex {
+			style = m.styles.MenuFocus
+			item = viewport.Focus(item)
+		}
+		items = append(items, style.Render(item))
+	}
+	return items
+}
+func (m *Menu) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	entries := m.Entries()
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+		}
+	case "down", "j":
+		if m.selectedIndex < len(entries)-1 {
+			m.selectedIndex++
+		}
+	case "enter":
+		return true, m.open(entries)
+	}
+	return true, nil
+}
+func (m *Menu) open(entries []types.MenuEntry) tea.Cmd {
+	if m.selectedIndex >= len(entries) {
+		return nil
+	}
+	path := entries[m.selectedIndex].Path
+	return func() tea.Msg {
+		return types.NavigateMsg{Path: path}
+	}
+}
+func (m *Menu) HandleMouse(msg tea.MouseMsg, id string) tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
+		return nil
+	}
+	entries := m.Entries()
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.HandleInput(tea.KeyMsg{Type: tea.KeyUp})
+	case tea.MouseButtonWheelDown:
+		m.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+	case tea.MouseButtonLeft:
+		for i, entry := range entries {
+			if id == "menu:"+entry.Path {
+				m.selectedIndex = i
+				return m.open(entries)
+			}
+		}
+	}
+	return nil
+}
+func (m *Menu) KeyBindings() []types.KeyBinding {
+	var bindings []types.KeyBinding
+	for _, entry := range m.Entries() {
+		bindings = append(bindings, types.KeyBinding{Key: entry.Key, Description: entry.Title, Action: entry.Action})
+	}
+	return append(bindings, types.KeyBinding{Key: "enter", Description: "Open selected", Action: "open_selected"})
+}
@@ -0,0 +1,267 @@
+// Package plugins
+package plugins
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/plugin"
+)
+type Entry struct {
+	Plugin plugin.Plugin
+	Info   plugin.PageInfo
+	Path   string
+	Key    string
+}
+type LoadedMsg struct {
+	Entries []Entry
+}
+func Discover() tea.Cmd {
+	return func() tea.Msg {
+		return LoadedMsg{Entries: Load(context.Background())}
+	}
+}
+func Load(ctx context.Context) []Entry {
+	found := plugin.Enabled(configfile.Current().Plugins.Path)
+	described := make([][]plugin.PageInfo, len(found))
+	var wg sync.WaitGroup
+	for i, p := range found {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			describeCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+			defer cancel()
+			if pages, err := p.Describe(describeCtx); err == nil {
+				described[i] = pages
+			}
+		}()
+	}
+	wg.Wait()
+	var entries []Entry
+	used := map[string]bool{"esc": true, "q": true, "t": true, "?": true}
+	next := 1
+	for i, p := range found {
+		for _, info := range described[i] {
+			if info.ID == "" {
+				continue
+			}
+			key := info.Key
+			if key == "" || used[key] {
+				key = strconv.Itoa(next)
+				next++
+			}
+			used[key] = true
+			entries = append(entries, Entry{
+				Plugin: p,
+				Info:   info,
+				Path:   "/plugins/" + p.Name + "/" + info.ID,
+				Key:    key,
+			})
+		}
+	}
+	return entries
+}
+type PageStyles struct {
+	Title       lipgloss.Style
+	Description lipgloss.Style
+	MenuItem    lipgloss.Style
+	KeyBinding  lipgloss.Style
+	Error       lipgloss.Style
+}
+func NewPageStyles() *PageStyles {
+	return &PageStyles{
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00D7FF")).
+			MarginBottom(1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#CCCCCC")).
+			MarginBottom(1),
+		MenuItem: lipgloss.NewStyle().
+			Padding(0, 2).
+			MarginBottom(1),
+		KeyBinding: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00D7FF")).
+			Background(lipgloss.Color("#1A1A1A")).
+			Padding(0, 1),
+		Error: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Padding(0, 2),
+	}
+}
+type IndexPage struct {
+	styles  *PageStyles
+	entries []Entry
+	loaded  bool
+}
+func NewIndexPage() *IndexPage {
+	return &IndexPage{
+		styles: NewPageStyles(),
+	}
+}
+func (p *IndexPage) SetEntries(entries []Entry) {
+	p.entries = entries
+	p.loaded = true
+}
+func (p *IndexPage) Render(width, height int) string {
+	var items []string
+	items = append(items, "🧩 Plugins")
+	items = append(items, "")
+	if !p.loaded {
+		items = append(items, p.styles.Description.Render("Looking for plugins..."))
+		return lipgloss.JoinVertical(lipgloss.Left, items...)
+	}
+	if len(p.entries) == 0 {
+		dir, _ := plugin.Dir()
+		items = append(items, p.styles.Description.Render("No plugin pages found."))
+		items = append(items, p.styles.Description.Render("Install executables named "+plugin.Prefix+"<name> in "+dir+", or enable ones on PATH with 'dev-tools config set plugins.path <name>'."))
+		return lipgloss.JoinVertical(lipgloss.Left, items...)
+	}
+	items = append(items, p.styles.Description.Render("Pages contributed by "+plugin.Prefix+"* executables."))
+	items = append(items, "")
+	for _, entry := range p.entries {
+		item := lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			p.styles.KeyBinding.Render("["+entry.Key+"]"),
+			" "+entry.Plugin.Name+": "+entry.Info.Title+" - ",
+			p.styles.Description.Render(entry.Info.Description),
+		)
+		items = append(items, p.styles.MenuItem.Render(item))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, items...)
+}
+func (p *IndexPage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	return true, nil
+}
+func (p *IndexPage) GetTitle() string {
+	return "Plugins"
+}
+func (p *IndexPage) GetKeyBindings() []types.KeyBinding {
+	var bindings []types.KeyBinding
+	for _, entry := range p.entries {
+		bindings = append(bindings, types.KeyBinding{Key: entry.Key, Description: entry.Info.Title, Action: types.RouteAction(entry.Path)})
+	}
+	return bindings
+}
+type ContentMsg struct {
+	Path    string
+	Request int
+	Content string
+	Err     error
+}
+type Page struct {
+	styles  *PageStyles
+	entry   Entry
+	content string
+	err     string
+	loaded  bool
+	width   int
+	height  int
+	request int
+	cancel  context.CancelFunc
+}
+func NewPage(entry Entry) *Page {
+	return &Page{
+		styles: NewPageStyles(),
+		entry:  entry,
+	}
+}
+func (p *Page) Render(width, height int) string {
+	var items []string
+	items = append(items, p.styles.Title.Render("🧩 "+p.entry.Info.Title))
+	if !p.loaded && p.err == "" {
+		items = append(items, p.styles.Description.Render("Loading..."))
+	}
+	if p.err != "" {
+		items = append(items, p.styles.Error.Render("❌ "+p.err))
+	}
+	items = append(items, p.content)
+	return lipgloss.JoinVertical(lipgloss.Left, items...)
+}
+func (p *Page) Resize(width, height int) {
+	p.width, p.height = width, height
+}
+func (p *Page) OnEnter() tea.Cmd {
+	if p.loaded {
+		return nil
+	}
+	return p.fetch(plugin.Request{Type: plugin.RequestRender})
+}
+func (p *Page) OnLeave() tea.Cmd {
+	p.Cancel()
+	return nil
+}
+func (p *Page) Busy() string {
+	if p.cancel != nil {
+		return "plugin request"
+	}
+	return ""
+}
+func (p *Page) Cancel() tea.Cmd {
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+	return nil
+}
+func (p *Page) fetch(req plugin.Request) func() tea.Msg {
+	p.Cancel()
+	entry := p.entry
+	req.Page = entry.Info.ID
+	req.Width, req.Height = p.width, p.height
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	p.request++
+	p.cancel = cancel
+	request := p.request
+	return func() tea.Msg {
+		defer cancel()
+		resp, err := entry.Plugin.Call(ctx, req)
+		if ctx.Err() == context.Canceled {
+			err = ctx.Err()
+		}
+		msg := ContentMsg{Path: entry.Path, Request: request, Err: err}
+		if resp != nil {
+			msg.Content = resp.Content
+		}
+		return msg
+	}
+}
+func (p *Page) apply(msg ContentMsg) {
+	if msg.Err == context.Canceled {
+		p.err = "Request canceled, press r to retry"
+		return
+	}
+	p.loaded = true
+	p.err = ""
+	if msg.Err != nil {
+		p.err = msg.Err.Error()
+		return
+	}
+	p.content = msg.Content
+}
+func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if msg.String() == "r" {
+		return true, p.fetch(plugin.Request{Type: plugin.RequestRender})
+	}
+	return true, p.fetch(plugin.Request{Type: plugin.RequestKey, Key: msg.String()})
+}
+func (p *Page) Update(msg tea.Msg) tea.Cmd {
+	if msg, ok := msg.(ContentMsg); ok && msg.Path == p.entry.Path && msg.Request == p.request {
+		p.cancel = nil
+		p.apply(msg)
+	}
+	return nil
+}
+func (p *Page) GetTitle() string {
+	return p.entry.Info.Title
+}
+func (p *Page) GetKeyBindings() []types.KeyBinding {
+	return []types.KeyBinding{
+		{Key: "r", Description: "Refresh", Action: "refresh"},
+	}
+}
@@ -0,0 +1,351 @@
+// Package tasks
+package tasks
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/process"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/tasks"
+)
+const (
+	maxLogLines = 500
+	jobOwner    = "tasks"
+	editID      = "tasks:edit"
+)
+type EventMsg struct {
+	JobID int
+	Event tasks.Event
+}
+type PageStyles struct {
+	Title       lipgloss.Style
+	Description lipgloss.Style
+	Option      lipgloss.Style
+	OptionFocus lipgloss.Style
+	Output      lipgloss.Style
+	Error       lipgloss.Style
+}
+func NewPageStyles() *PageStyles {
+	return &PageStyles{
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00D7FF")).
+			MarginBottom(1),
+		Description: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#CCCCCC")),
+		Option: lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("#CCCCCC")),
+		OptionFocus: lipgloss.NewStyle().
+			Padding(0, 2).
+			Background(lipgloss.Color("#383838")).
+			Foreground(lipgloss.Color("#FFFFFF")),
+		Output: lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("#CCCCCC")),
+		Error: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF6B6B")).
+			Padding(0, 2),
+	}
+}
+type Page struct {
+	styles        *PageStyles
+	file          *tasks.File
+	names         []string
+	loadErr       string
+	selectedIndex int
+	force         bool
+	running       bool
+	status        map[string]string
+	log           []string
+	scroll        int
+	jobID         int
+	cancelPending bool
+	dir           string
+}
+func NewPage() *Page {
+	p := &Page{
+		styles: NewPageStyles(),
+		status: make(map[string]string),
+	}
+	p.load()
+	return p
+}
+func (p *Page) load() {
+	p.loadErr = ""
+	p.names = nil
+	path, err := p.projectPath()
+	if err != nil {
+		p.loadErr = err.Error()
+		return
+	}
+	file, err := tasks.Load(path)
+	if err != nil {
+		p.loadErr = err.Error()
+		return
+	}
+	p.file = file
+	p.names = file.Names()
+	if p.selectedIndex >= len(p.names) {
+		p.selectedIndex = 0
+	}
+}
+func (p *Page) Render(width, height int) string {
+	var content []string
+	content = append(content, p.styles.Title.Render("⚙️  Tasks"))
+	if p.loadErr != "" {
+		content = append(content, p.styles.Error.Render("❌ "+p.loadErr))
+		return lipgloss.JoinVertical(lipgloss.Left, content...)
+	}
+	if len(p.names) == 0 {
+		content = append(content, p.styles.Description.Render("No tasks defined in "+p.file.Path))
+		content = append(content, p.styles.Description.Render("Add a tasks: section with cmds, deps, env, dir, inputs and outputs."))
+		return lipgloss.JoinVertical(lipgloss.Left, content...)
+	}
+	content = append(content, p.styles.Description.Render(p.file.Path))
+	content = append(content, "")
+	for i, name := range p.names {
+		task := p.file.Tasks[name]
+		line := fmt.Sprintf("%s %s", p.statusIcon(name), name)
+		if task.Description != "" {
+			line += " - " + task.Description
+		}
+		style := p.styles.Option
+		prefix := "  "
+		if i == p.selectedIndex {
+			style = p.styles.OptionFocus
+			prefix = "▶ "
+		}
+		item := style.Render(prefix + line)
+		if i == p.selectedIndex {
+			item = viewport.Focus(item)
+		}
+		content = append(content, item)
+	}
+	if p.force {
+		content = append(content, "", p.styles.Description.Render("Force mode: up-to-date checks are ignored"))
+	}
+	if len(p.log) > 0 {
+		content = append(content, "", p.styles.Description.Render("📤 Output:"))
+		lines := p.log[:len(p.log)-p.scroll]
+		if limit := max(5, height-len(p.names)-8); len(lines) > limit {
+			lines = lines[len(lines)-limit:]
+		}
+		content = append(content, zone.Mark("tasks:output", p.styles.Output.Render(strings.Join(lines, "\n"))))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
+func (p *Page) statusIcon(name string) string {
+	switch p.status[name] {
+	case "running":
+		return "⏳"
+	case string(tasks.StatusOK):
+		return "✅"
+	case string(tasks.StatusUpToDate), string(tasks.StatusSkipped):
+		return "⏭️"
+	case string(tasks.StatusFailed):
+		return "❌"
+	}
+	return "•"
+}
+func (p *Page) run(name string) tea.Cmd {
+	p.running = true
+	p.jobID = 0
+	p.cancelPending = false
+	p.log = nil
+	p.scroll = 0
+	p.status = make(map[string]string)
+	runner := tasks.NewRunner(p.file).WithForce(p.force)
+	return jobs.Run(jobOwner, "Task "+name, func(ctx context.Context, job *jobs.Job) error {
+		runner.WithHandler(func(event tasks.Event) {
+			job.Send(EventMsg{JobID: job.ID, Event: event})
+			job.Log(eventLine(event))
+		})
+		_, err := runner.Run(ctx, name)
+		return err
+	})
+}
+func eventLine(event tasks.Event) string {
+	switch event.Type {
+	case tasks.EventStart:
+		return "▶ " + event.Task
+	case tasks.EventSkip:
+		return "⏭ " + event.Task + ": " + event.Line
+	case tasks.EventDone:
+		return fmt.Sprintf("✔ %s (%s)", event.Task, event.Duration.Round(time.Millisecond))
+	case tasks.EventFail:
+		return fmt.Sprintf("✘ %s: %v", event.Task, event.Err)
+	}
+	return "[" + event.Task + "] " + event.Line
+}
+func (p *Page) appendLog(line string) {
+	p.log = append(p.log, line)
+	if len(p.log) > maxLogLines {
+		p.log = p.log[len(p.log)-maxLogLines:]
+	}
+	if p.scroll > 0 {
+		p.scroll = min(p.scroll+1, len(p.log)-1)
+	}
+}
+func (p *Page) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case jobs.StartedMsg:
+		if msg.Owner != jobOwner || (p.running && p.jobID != 0) {
+			return nil
+		}
+		if !p.running {
+			p.running = true
+			p.log = nil
+			p.scroll = 0
+			p.status = make(map[string]string)
+		}
+		p.jobID = msg.ID
+		if p.cancelPending {
+			p.cancelPending = false
+			return jobs.Cancel(msg.ID)
+		}
+	case EventMsg:
+		if msg.JobID != p.jobID {
+			return nil
+		}
+		event := msg.Event
+		switch event.Type {
+		case tasks.EventStart:
+			p.status[event.Task] = "running"
+		case tasks.EventSkip:
+			p.status[event.Task] = string(tasks.StatusSkipped)
+		case tasks.EventDone:
+			p.status[event.Task] = string(tasks.StatusOK)
+		case tasks.EventFail:
+			p.status[event.Task] = string(tasks.StatusFailed)
+		}
+		p.appendLog(eventLine(event))
+	case process.DoneMsg:
+		if msg.ID == editID {
+			p.Reload()
+		}
+	case jobs.DoneMsg:
+		if msg.ID != p.jobID {
+			return nil
+		}
+		p.running = false
+		if msg.Status == jobs.StatusCanceled {
+			for name, status := range p.status {
+				if status == "running" || status == string(tasks.StatusFailed) {
+					delete(p.status, name)
+				}
+			}
+			p.appendLog("⏹️  Canceled")
+		} else if msg.Err != nil {
+			p.appendLog("❌ " + msg.Err.Error())
+		} else {
+			p.appendLog("🎉 Done")
+		}
+	}
+	return nil
+}
+func (p *Page) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if p.selectedIndex > 0 {
+			p.selectedIndex--
+		}
+	case "down", "j":
+		if p.selectedIndex < len(p.names)-1 {
+			p.selectedIndex++
+		}
+	case "enter":
+		if p.running || len(p.names) == 0 {
+			return true, nil
+		}
+		return true, p.run(p.names[p.selectedIndex])
+	case "f":
+		p.force = !p.force
+	case "r":
+		p.Reload()
+	case "e":
+		if p.running {
+			return true, nil
+		}
+		return true, p.edit()
+	}
+	return true, nil
+}
+func (p *Page) Busy() string {
+	if p.running {
+		return "task run"
+	}
+	return ""
+}
+func (p *Page) Cancel() tea.Cmd {
+	if p.jobID == 0 {
+		p.cancelPending = true
+		return nil
+	}
+	return jobs.Cancel(p.jobID)
+}
+func (p *Page) edit() tea.Cmd {
+	if p.file != nil {
+		return process.Edit(editID, p.file.Path)
+	}
+	path, err := p.projectPath()
+	if err != nil {
+		p.loadErr = err.Error()
+		return nil
+	}
+	return process.Edit(editID, path)
+}
+func (p *Page) OnEnter() tea.Cmd {
+	p.Reload()
+	return nil
+}
+func (p *Page) Reload() {
+	if !p.running {
+		p.load()
+	}
+}
+func (p *Page) OpenProject(dir string) {
+	p.dir = dir
+	p.Reload()
+}
+func (p *Page) projectPath() (string, error) {
+	if p.dir != "" {
+		return configfile.ProjectPath(p.dir), nil
+	}
+	return configfile.LocalPath()
+}
+func (p *Page) HandleMouse(msg tea.MouseMsg, id string) tea.Cmd {
+	if msg.Action != tea.MouseActionPress {
+		return nil
+	}
+	switch {
+	case id == "tasks:output" && msg.Button == tea.MouseButtonWheelUp:
+		p.scroll = min(p.scroll+3, max(0, len(p.log)-1))
+	case id == "tasks:output" && msg.Button == tea.MouseButtonWheelDown:
+		p.scroll = max(p.scroll-3, 0)
+	case msg.Button == tea.MouseButtonWheelUp:
+		p.HandleInput(tea.KeyMsg{Type: tea.KeyUp})
+	case msg.Button == tea.MouseButtonWheelDown:
+		p.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	return nil
+}
+func (p *Page) GetTitle() string {
+	return "Tasks"
+}
+func (p *Page) GetKeyBindings() []types.KeyBinding {
+	return []types.KeyBinding{
+		{Key: "↑/↓", Description: "Select", Action: "select"},
+		{Key: "enter", Description: "Run", Action: "run"},
+		{Key: "f", Description: "Toggle force", Action: "force"},
+		{Key: "r", Description: "Reload", Action: "reload"},
+		{Key: "e", Description: "Edit in $EDITOR", Action: "edit"},
+	}
+}
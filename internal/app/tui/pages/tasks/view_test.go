@@ -0,0 +1,70 @@
+package tasks
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/tasks"
+)
+func TestCancelBeforeStart(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	if err := os.WriteFile(configfile.FileName, []byte("tasks:\n  build:\n    cmds: [go build ./...]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p := NewPage()
+	if p.loadErr != "" || len(p.names) != 1 {
+		t.Fatalf("NewPage() loaded %v, error %q", p.names, p.loadErr)
+	}
+	if cmd := p.run("build"); cmd == nil {
+		t.Fatal("run() should start a job")
+	}
+	if p.Busy() == "" {
+		t.Fatal("Busy() should report the task run")
+	}
+	if cmd := p.Cancel(); cmd != nil {
+		t.Errorf("Cancel() before the job started = %v, want it deferred", cmd)
+	}
+	if cmd := p.Update(jobs.StartedMsg{ID: 4, Owner: "blueprint"}); cmd != nil || p.jobID != 0 {
+		t.Error("another page's job should not pick up the pending cancel")
+	}
+	cmd := p.Update(jobs.StartedMsg{ID: 3, Owner: jobOwner})
+	if cmd == nil {
+		t.Fatal("StartedMsg should apply the pending cancel")
+	}
+	if msg, ok := cmd().(jobs.CancelMsg); !ok || msg.ID != 3 {
+		t.Errorf("cmd() = %#v, want jobs.CancelMsg{ID: 3}", msg)
+	}
+	if msg, ok := p.Cancel()().(jobs.CancelMsg); !ok || msg.ID != 3 {
+		t.Errorf("Cancel() once started = %#v, want jobs.CancelMsg{ID: 3}", msg)
+	}
+}
+func TestOpenProject(t *testing.T) {
+	t.Chdir(t.TempDir())
+	p := NewPage()
+	if len(p.names) != 0 {
+		t.Fatalf("NewPage() loaded %v from an empty directory", p.names)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, configfile.FileName), []byte("tasks:\n  lint:\n    cmds: [go vet ./...]\n  test:\n    cmds: [go test ./...]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p.OpenProject(dir)
+	if p.loadErr != "" || len(p.names) != 2 || p.file.Dir != dir {
+		t.Errorf("OpenProject() loaded %v from %q, error %q", p.names, p.file.Dir, p.loadErr)
+	}
+}
+func TestEventsFromOtherJobsAreIgnored(t *testing.T) {
+	t.Chdir(t.TempDir())
+	p := NewPage()
+	p.Update(jobs.StartedMsg{ID: 3, Owner: jobOwner})
+	p.Update(EventMsg{JobID: 2, Event: tasks.Event{Type: tasks.EventStart, Task: "stale"}})
+	p.Update(EventMsg{JobID: 3, Event: tasks.Event{Type: tasks.EventStart, Task: "build"}})
+	if _, ok := p.status["stale"]; ok {
+		t.Error("an event from a previous job changed the status")
+	}
+	if p.status["build"] != "running" || len(p.log) != 1 {
+		t.Errorf("status = %v, log = %v, want only the current job's event", p.status, p.log)
+	}
+}
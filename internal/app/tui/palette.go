@@ -0,0 +1,287 @@
+package tui
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/keymap"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/langs/golang/blueprint"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/process"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/recent"
+)
+const paletteLimit = 12
+type PaletteItem struct {
+	Kind   string
+	Title  string
+	Detail string
+	Run    func(m *Model) tea.Cmd
+}
+type Palette struct {
+	items         []PaletteItem
+	matches       []PaletteItem
+	query         string
+	selectedIndex int
+	styles        *PaletteStyles
+}
+type PaletteStyles struct {
+	Box    lipgloss.Style
+	Input  lipgloss.Style
+	Item   lipgloss.Style
+	Focus  lipgloss.Style
+	Kind   lipgloss.Style
+	Detail lipgloss.Style
+}
+func NewPaletteStyles(t *theme.Theme) *PaletteStyles {
+	return &PaletteStyles{
+		Box: lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(t.Primary).
+			Padding(0, 1),
+		Input: lipgloss.NewStyle().
+			Bold(true).
+			MarginBottom(1),
+		Item: lipgloss.NewStyle().
+			Padding(0, 1),
+		Focus: lipgloss.NewStyle().
+			Padding(0, 1).
+			Reverse(true),
+		Kind: lipgloss.NewStyle().
+			Foreground(t.Info).
+			Width(9),
+		Detail: lipgloss.NewStyle().
+			Foreground(t.Muted).
+			Faint(true),
+	}
+}
+func NewPalette(items []PaletteItem, t *theme.Theme) *Palette {
+	p := &Palette{
+		items:  items,
+		styles: NewPaletteStyles(t),
+	}
+	p.filter()
+	return p
+}
+func (p *Palette) filter() {
+	type scored struct {
+		item  PaletteItem
+		score int
+	}
+	var results []scored
+	for _, item := range p.items {
+		if score, ok := fuzzyScore(p.query, item.Title+" "+item.Detail); ok {
+			results = append(results, scored{item: item, score: score})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+	p.matches = p.matches[:0]
+	for _, result := range results {
+		p.matches = append(p.matches, result.item)
+	}
+	p.selectedIndex = 0
+}
+func fuzzyScore(query, text string) (int, bool) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return 0, true
+	}
+	runes := []rune(strings.ToLower(text))
+	score := 0
+	last := -1
+	for _, q := range query {
+		if q == ' ' {
+			continue
+		}
+		found := -1
+		for i := last + 1; i < len(runes); i++ {
+			if runes[i] == q {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			return 0, false
+		}
+		switch {
+		case found == last+1:
+			score += 5
+		case found == 0 || !unicode.IsLetter(runes[found-1]):
+			score += 3
+		default:
+			score -= min(found-last, 5)
+		}
+		last = found
+	}
+	return score, true
+}
+func (p *Palette) HandleInput(msg tea.KeyMsg) (closed bool, selected *PaletteItem) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return true, nil
+	case tea.KeyEnter:
+		if p.selectedIndex < len(p.matches) {
+			item := p.matches[p.selectedIndex]
+			return true, &item
+		}
+		return true, nil
+	case tea.KeyUp, tea.KeyCtrlK, tea.KeyCtrlP:
+		if p.selectedIndex > 0 {
+			p.selectedIndex--
+		}
+	case tea.KeyDown, tea.KeyCtrlJ, tea.KeyCtrlN, tea.KeyTab:
+		if p.selectedIndex < len(p.matches)-1 {
+			p.selectedIndex++
+		}
+	case tea.KeyBackspace:
+		if runes := []rune(p.query); len(runes) > 0 {
+			p.query = string(runes[:len(runes)-1])
+			p.filter()
+		}
+	case tea.KeyCtrlU:
+		p.query = ""
+		p.filter()
+	case tea.KeyRunes, tea.KeySpace:
+		p.query += string(msg.Runes)
+		p.filter()
+	}
+	return false, nil
+}
+func (p *Palette) Render(width int) string {
+	var lines []string
+	lines = append(lines, p.styles.Input.Render("> "+p.query+"█"))
+	start := 0
+	if p.selectedIndex >= paletteLimit {
+		start = p.selectedIndex - paletteLimit + 1
+	}
+	for i := start; i < len(p.matches) && i < start+paletteLimit; i++ {
+		item := p.matches[i]
+		line := p.styles.Kind.Render(item.Kind) + item.Title
+		if item.Detail != "" {
+			line += "  " + p.styles.Detail.Render(item.Detail)
+		}
+		style := p.styles.Item
+		if i == p.selectedIndex {
+			style = p.styles.Focus
+		}
+		lines = append(lines, style.Render(line))
+	}
+	if len(p.matches) == 0 {
+		lines = append(lines, p.styles.Detail.Render("No matches"))
+	}
+	lines = append(lines, "", p.styles.Detail.Render(fmt.Sprintf("%d/%d · ↑/↓ select · enter run · esc close", len(p.matches), len(p.items))))
+	return p.styles.Box.Width(max(40, width-8)).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+func (m *Model) paletteItems() []PaletteItem {
+	var items []PaletteItem
+	routes := m.router.sortedRoutes()
+	for _, route := range routes {
+		path := route.Path
+		items = append(items, PaletteItem{
+			Kind:   "page",
+			Title:  route.Title,
+			Detail: path,
+			Run: func(m *Model) tea.Cmd {
+				return m.router.navigate(path)
+			},
+		})
+	}
+	for _, route := range routes {
+		for _, kb := range route.Component.GetKeyBindings() {
+			key, ok := keymap.Msg(kb.Key)
+			if !ok || strings.HasPrefix(kb.Action, "navigate_") || kb.Action == "open_selected" {
+				continue
+			}
+			path := route.Path
+			items = append(items, PaletteItem{
+				Kind:   "action",
+				Title:  route.Title + ": " + kb.Description,
+				Detail: "[" + kb.Key + "]",
+				Run: func(m *Model) tea.Cmd {
+					enter, err := m.router.NavigateTo(path)
+					if err != nil {
+						return m.toasts.Push(overlay.Error, "🔒 "+err.Error())
+					}
+					_, cmd := m.router.GetCurrentRoute().Component.HandleInput(key)
+					return tea.Batch(enter, cmd)
+				},
+			})
+		}
+	}
+	presets := configfile.Current().Presets
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		preset := presets[name]
+		items = append(items, PaletteItem{
+			Kind:   "preset",
+			Title:  "New project from preset " + name,
+			Detail: strings.TrimSpace(preset.Framework + " " + preset.Driver + " " + strings.Join(preset.Features, " ")),
+			Run: func(m *Model) tea.Cmd {
+				enter, err := m.router.NavigateTo("/langs/golang/blueprint")
+				if err != nil {
+					return m.toasts.Push(overlay.Error, "🔒 "+err.Error())
+				}
+				if page, ok := m.router.GetCurrentRoute().Component.(*blueprint.Page); ok {
+					if err := page.UsePreset(name); err != nil {
+						return tea.Batch(enter, m.toasts.Push(overlay.Error, "❌ "+err.Error()))
+					}
+				}
+				return enter
+			},
+		})
+	}
+	for _, scope := range []configfile.Scope{configfile.ScopeGlobal, configfile.ScopeLocal} {
+		path, err := configfile.PathFor(scope)
+		if err != nil {
+			continue
+		}
+		items = append(items, PaletteItem{
+			Kind:   "command",
+			Title:  fmt.Sprintf("Edit %s config in $EDITOR", scope),
+			Detail: path,
+			Run: func(m *Model) tea.Cmd {
+				return process.Edit("palette:edit", path)
+			},
+		})
+	}
+	projects, _ := recent.List()
+	for _, project := range projects {
+		dir := project.Path
+		items = append(items, PaletteItem{
+			Kind:   "project",
+			Title:  "Open " + project.Name,
+			Detail: dir,
+			Run: func(m *Model) tea.Cmd {
+				return m.openProject(dir)
+			},
+		})
+	}
+	return items
+}
+func (m *Model) openProject(dir string) tea.Cmd {
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return m.toasts.Push(overlay.Error, "❌ Not a directory: "+dir)
+	}
+	recent.Add(dir)
+	if err := configfile.OpenProject(dir); err != nil {
+		return m.toasts.Push(overlay.Error, "❌ Config not reloaded: "+err.Error())
+	}
+	m.applyConfig()
+	for _, route := range m.router.GetAllRoutes() {
+		if opener, ok := route.Component.(types.ProjectOpener); ok {
+			opener.OpenProject(dir)
+		}
+	}
+	return m.toasts.Push(overlay.Info, "📂 Working in "+dir)
+}
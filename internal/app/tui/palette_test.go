@@ -0,0 +1,67 @@
+package tui
+import (
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+)
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		query string
+		text  string
+		ok    bool
+	}{
+		{query: "", text: "anything", ok: true},
+		{query: "cfg", text: "Config", ok: true},
+		{query: "go bp", text: "Go Blueprint", ok: true},
+		{query: "xyz", text: "Config", ok: false},
+		{query: "gifnoc", text: "Config", ok: false},
+	}
+	for _, tt := range tests {
+		if _, ok := fuzzyScore(tt.query, tt.text); ok != tt.ok {
+			t.Errorf("fuzzyScore(%q, %q) ok = %v, want %v", tt.query, tt.text, ok, tt.ok)
+		}
+	}
+	prefix, _ := fuzzyScore("con", "Config")
+	scattered, _ := fuzzyScore("con", "Choose a new Option")
+	if prefix <= scattered {
+		t.Errorf("a contiguous match scored %d, not above a scattered one at %d", prefix, scattered)
+	}
+}
+func TestPaletteHandleInput(t *testing.T) {
+	p := NewPalette([]PaletteItem{
+		{Kind: "page", Title: "Home", Detail: "/"},
+		{Kind: "page", Title: "Config", Detail: "/config"},
+		{Kind: "page", Title: "Tasks", Detail: "/tasks"},
+	}, theme.Themeless())
+	if len(p.matches) != 3 {
+		t.Fatalf("matches = %d, want every item for an empty query", len(p.matches))
+	}
+	for _, r := range "tsk" {
+		p.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if len(p.matches) != 1 || p.matches[0].Title != "Tasks" {
+		t.Fatalf("matches for tsk = %v", p.matches)
+	}
+	p.HandleInput(tea.KeyMsg{Type: tea.KeyCtrlU})
+	p.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+	p.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+	p.HandleInput(tea.KeyMsg{Type: tea.KeyDown})
+	p.HandleInput(tea.KeyMsg{Type: tea.KeyUp})
+	closed, selected := p.HandleInput(tea.KeyMsg{Type: tea.KeyEnter})
+	if !closed || selected == nil || selected.Title != "Config" {
+		t.Errorf("enter = (%v, %v), want Config selected", closed, selected)
+	}
+	if closed, selected := p.HandleInput(tea.KeyMsg{Type: tea.KeyEsc}); !closed || selected != nil {
+		t.Errorf("esc = (%v, %v), want closed without a selection", closed, selected)
+	}
+}
+func TestCtrlCQuitsWithPaletteOpen(t *testing.T) {
+	m := &Model{router: newTestRouter(), palette: NewPalette(nil, theme.Themeless())}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd == nil {
+		t.Fatal("ctrl+c with the palette open returned no command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Errorf("ctrl+c with the palette open = %T, want tea.QuitMsg", cmd())
+	}
+}
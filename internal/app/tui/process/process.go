@@ -0,0 +1,40 @@
+// Package process
+package process
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+)
+type DoneMsg struct {
+	ID       string
+	Command  string
+	ExitCode int
+	Err      error
+	Duration time.Duration
+}
+func (m DoneMsg) Success() bool {
+	return m.ExitCode == 0 && m.Err == nil
+}
+func Exec(id string, cmd *exec.Cmd) tea.Cmd {
+	command := strings.Join(cmd.Args, " ")
+	start := time.Now()
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		msg := DoneMsg{ID: id, Command: command, Err: err, Duration: time.Since(start)}
+		if exitError, ok := err.(*exec.ExitError); ok {
+			msg.ExitCode = exitError.ExitCode()
+		} else if err != nil {
+			msg.ExitCode = -1
+		}
+		return msg
+	})
+}
+func Run(id string, e *executor.CommandExecutor, command string, args ...string) tea.Cmd {
+	return Exec(id, e.Command(context.Background(), command, args...))
+}
+func Edit(id, path string) tea.Cmd {
+	editor := executor.Editor()
+	return Run(id, executor.NewExecutor(), editor[0], append(editor[1:], path)...)
+}
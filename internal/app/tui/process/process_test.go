@@ -0,0 +1,16 @@
+package process
+import (
+	"errors"
+	"testing"
+)
+func TestDoneMsgSuccess(t *testing.T) {
+	for msg, want := range map[*DoneMsg]bool{
+		{}:                                  true,
+		{ExitCode: 2}:                       false,
+		{ExitCode: -1, Err: errors.New("")}: false,
+	} {
+		if got := msg.Success(); got != want {
+			t.Errorf("%+v.Success() = %v, want %v", *msg, got, want)
+		}
+	}
+}
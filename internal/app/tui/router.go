@@ -1,26 +1,52 @@
 // Package tui
 package tui
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/keymap"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
 )
+const cancelModal = "tui:cancel"
+var ErrDuplicateAction = errors.New("duplicate route action")
 type Route struct {
 	Path        string
 	Component   types.PageRenderer
 	Title       string
 	Description string
 	KeyBinding  string
+	DefaultKey  string
+	Action      string
+	Parent      string
+	Scope       KeyScope
+	Guard       Guard
+	order       int
 }
 type Router struct {
 	routes       map[string]*Route
+	actions      map[string]string
 	currentRoute string
 	history      []string
 	styles       *RouterStyles
 	theme        *theme.Theme
+	profile      string
+	keys         *keymap.Keymap
+	handlers     map[string]func() tea.Cmd
+	zones        *zone.Manager
+	viewports    map[string]*viewport.Viewport
+	compact      bool
+	jobs         *jobs.Manager
+	start        tea.Cmd
+	started      bool
 }
 type RouterStyles struct {
 	Header    lipgloss.Style
@@ -33,10 +59,16 @@ func NewRouter() *Router {
 	currentTheme := theme.Themeless()
 	return &Router{
 		routes:       make(map[string]*Route),
+		actions:      make(map[string]string),
 		currentRoute: "/",
 		history:      make([]string, 0),
 		styles:       NewRouterStyles(currentTheme),
 		theme:        currentTheme,
+		keys:         keymap.New(),
+		handlers:     make(map[string]func() tea.Cmd),
+		zones:        zone.NewManager(),
+		viewports:    make(map[string]*viewport.Viewport),
+		jobs:         jobs.NewManager(),
 	}
 }
 func NewRouterStyles(t *theme.Theme) *RouterStyles {
@@ -49,7 +81,6 @@ func NewRouterStyles(t *theme.Theme) *RouterStyles {
 			MarginBottom(1),
 		Content: lipgloss.NewStyle().
 			Padding(1, 0).
-			Height(20).
 			Foreground(t.Foreground),
 		Footer: lipgloss.NewStyle().
 			Foreground(t.Muted).
@@ -70,33 +101,92 @@ func NewRouterStyles(t *theme.Theme) *RouterStyles {
 			BorderForeground(t.Error),
 	}
 }
-func (r *Router) RegisterRoute(path string, component types.PageRenderer, title, description, keyBinding string) {
-	r.routes[path] = &Route{
+func (r *Router) RegisterRoute(path string, component types.PageRenderer, title, description, keyBinding string) (*Route, error) {
+	action := types.RouteAction(path)
+	if owner, ok := r.actions[action]; ok && owner != path {
+		return nil, fmt.Errorf("%w %s: %s and %s", ErrDuplicateAction, action, owner, path)
+	}
+	route := &Route{
 		Path:        path,
 		Component:   component,
 		Title:       title,
 		Description: description,
 		KeyBinding:  keyBinding,
+		DefaultKey:  keyBinding,
+		Action:      action,
+		Parent:      routeParent(path),
+		order:       len(r.routes),
+	}
+	if existing, ok := r.routes[path]; ok {
+		route.order = existing.order
+	}
+	r.routes[path] = route
+	r.actions[action] = path
+	return route, nil
+}
+func (r *Router) ApplyKeymap(preset string, overrides map[string]string) {
+	r.keys.Apply(preset, overrides)
+	for _, route := range r.routes {
+		route.KeyBinding = route.DefaultKey
+		if key, ok := overrides[route.Action]; ok && key != "" {
+			route.KeyBinding = key
+		}
 	}
 }
-func (r *Router) NavigateTo(path string) error {
-	if _, exists := r.routes[path]; !exists {
-		return fmt.Errorf("route '%s' not found", path)
+func (r *Router) RouteBindings() []types.RouteBinding {
+	paths := make([]string, 0, len(r.routes))
+	for path := range r.routes {
+		paths = append(paths, path)
 	}
-	if r.currentRoute != path {
-		r.history = append(r.history, r.currentRoute)
+	sort.Strings(paths)
+	bindings := make([]types.RouteBinding, 0, len(paths))
+	for _, path := range paths {
+		route := r.routes[path]
+		bindings = append(bindings, types.RouteBinding{
+			Path:       route.Path,
+			Title:      route.Title,
+			Action:     route.Action,
+			Key:        route.KeyBinding,
+			DefaultKey: route.DefaultKey,
+		})
 	}
-	r.currentRoute = path
-	return nil
+	return bindings
 }
-func (r *Router) GoBack() error {
+func (r *Router) NavigateTo(target string) (tea.Cmd, error) {
+	path, query, _ := strings.Cut(target, "?")
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameters in '%s': %w", target, err)
+	}
+	route, exists := r.routes[path]
+	if !exists {
+		return nil, fmt.Errorf("route '%s' not found", path)
+	}
+	if err := route.Blocked(); err != nil {
+		return nil, fmt.Errorf("%s %w", route.Title, err)
+	}
+	if len(params) > 0 {
+		receiver, ok := route.Component.(types.ParamReceiver)
+		if !ok {
+			return nil, fmt.Errorf("%s does not accept parameters", route.Title)
+		}
+		if err := receiver.SetParams(params); err != nil {
+			return nil, fmt.Errorf("%s: %w", route.Title, err)
+		}
+	}
+	if r.currentRoute == path {
+		return nil, nil
+	}
+	r.history = append(r.history, r.currentRoute)
+	return r.switchTo(path), nil
+}
+func (r *Router) GoBack() (tea.Cmd, error) {
 	if len(r.history) == 0 {
-		return fmt.Errorf("no previous route in history")
+		return nil, fmt.Errorf("no previous route in history")
 	}
 	lastRoute := r.history[len(r.history)-1]
 	r.history = r.history[:len(r.history)-1]
-	r.currentRoute = lastRoute
-	return nil
+	return r.switchTo(lastRoute), nil
 }
 func (r *Router) GetCurrentRoute() *Route {
 	return r.routes[r.currentRoute]
@@ -104,59 +194,74 @@ func (r *Router) GetCurrentRoute() *Route {
 func (r *Router) GetAllRoutes() map[string]*Route {
 	return r.routes
 }
-func (r *Router) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
-	switch msg.String() {
-	case "ctrl+c", "q":
-		return false, tea.Quit
-	case "esc":
-		if err := r.GoBack(); err != nil {
-			r.NavigateTo("/")
-		}
-		return true, nil
+func (r *Router) Capturing() bool {
+	if r.viewport().Searching() {
+		return true
 	}
-	availableRoutes := r.getAvailableRoutes()
-	for path, route := range availableRoutes {
-		if route.KeyBinding == msg.String() {
-			r.NavigateTo(path)
-			return true, nil
+	if currentRoute := r.GetCurrentRoute(); currentRoute != nil {
+		if capturer, ok := currentRoute.Component.(types.InputCapturer); ok {
+			return capturer.CapturesInput()
 		}
 	}
+	return false
+}
+func (r *Router) busy() (types.Canceler, string) {
 	if currentRoute := r.GetCurrentRoute(); currentRoute != nil {
-		return currentRoute.Component.HandleInput(msg)
+		if canceler, ok := currentRoute.Component.(types.Canceler); ok {
+			return canceler, canceler.Busy()
+		}
 	}
-	return true, nil
+	return nil, ""
 }
-func (r *Router) getAvailableRoutes() map[string]*Route {
-	available := make(map[string]*Route)
-	switch r.currentRoute {
-	case "/":
-		for path, route := range r.routes {
-			if path == "/langs" || path == "/config" || path == "/help" {
-				available[path] = route
-			}
-		}
-	case "/langs":
-		for path, route := range r.routes {
-			if strings.HasPrefix(path, "/langs/") && strings.Count(path, "/") == 2 {
-				available[path] = route
-			}
+func (r *Router) CancelBusy() tea.Cmd {
+	canceler, busy := r.busy()
+	if busy == "" {
+		return nil
+	}
+	return canceler.Cancel()
+}
+func (r *Router) back() tea.Cmd {
+	cmd, err := r.GoBack()
+	if err != nil {
+		cmd, _ = r.NavigateTo("/")
+	}
+	return cmd
+}
+func (r *Router) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if r.viewport().Searching() {
+		if msg.String() == "ctrl+c" {
+			return false, tea.Quit
 		}
-	case "/langs/golang":
-		for path, route := range r.routes {
-			if strings.HasPrefix(path, "/langs/golang/") && strings.Count(path, "/") == 3 {
-				available[path] = route
+		r.viewport().HandleSearch(msg)
+		return true, nil
+	}
+	if _, busy := r.busy(); busy != "" && (msg.String() == "esc" || msg.String() == "ctrl+c") {
+		return true, overlay.Confirm(cancelModal, "Cancel "+busy+"?", "Only this action is stopped. Press ctrl+c again to quit instead.")
+	}
+	if r.Capturing() {
+		currentRoute := r.GetCurrentRoute()
+		switch msg.String() {
+		case "ctrl+c":
+			return false, tea.Quit
+		case "esc":
+			if handled, cmd := currentRoute.Component.HandleInput(msg); handled {
+				return true, cmd
 			}
+			return true, r.back()
 		}
-	default:
+		return currentRoute.Component.HandleInput(msg)
+	}
+	if msg.String() == "ctrl+c" {
+		return false, tea.Quit
 	}
-	return available
+	return r.handleKey(msg)
 }
 func (r *Router) RenderCurrentPage(width, height int) string {
 	currentRoute := r.GetCurrentRoute()
 	if currentRoute == nil {
 		return r.styles.Error.Render("Error: Route not found")
 	}
-	return currentRoute.Component.Render(width, height)
+	return r.viewport().Render(currentRoute.Component.Render(width, height), width, height)
 }
 func (r *Router) RenderHeader(width int) string {
 	title := "🛠️  Dev Tools TUI"
@@ -166,10 +271,13 @@ func (r *Router) RenderHeader(width int) string {
 	if currentRoute != nil && currentRoute.Title != "" {
 		subtitle = currentRoute.Title
 	}
+	if r.compact {
+		return r.styles.Header.MarginBottom(0).Width(width).MaxHeight(1).Render(title + " · " + subtitle)
+	}
 	headerTitle := lipgloss.JoinHorizontal(
 		lipgloss.Left,
 		title,
-		strings.Repeat(" ", max(0, width-len(title)-len(version)-8)),
+		strings.Repeat(" ", max(0, width-len(title)-len(version)-4)),
 		version,
 	)
 	headerContent := lipgloss.JoinVertical(
@@ -180,24 +288,52 @@ func (r *Router) RenderHeader(width int) string {
 			Foreground(lipgloss.Color("#CCCCCC")).
 			Render(subtitle),
 	)
-	return r.styles.Header.Width(width - 4).Render(headerContent)
+	return r.styles.Header.Width(width).Render(headerContent)
 }
 func (r *Router) RenderFooter(width int) string {
 	var footerItems []string
+	if r.viewport().Searching() {
+		footerItems = append(footerItems, "[enter] Next match", "[↑/↓] Previous/next", footerItem(targetPress+"esc", "[esc] Close search"))
+		footerItems = append(footerItems, footerItem(targetAction+keymap.Quit, "[ctrl+c] Exit"))
+		return r.footerStyle(width).Render("💡 " + strings.Join(footerItems, " | "))
+	}
+	ownsEsc := false
 	if currentRoute := r.GetCurrentRoute(); currentRoute != nil {
 		keyBindings := currentRoute.Component.GetKeyBindings()
 		for _, kb := range keyBindings {
-			footerItems = append(footerItems, fmt.Sprintf("[%s] %s", kb.Key, kb.Description))
+			footerItems = append(footerItems, footerItem(r.footerTarget(kb), fmt.Sprintf("[%s] %s", r.effectiveKey(kb), kb.Description)))
+			ownsEsc = ownsEsc || kb.Key == "esc"
 		}
 	}
-	footerItems = append(footerItems, "[esc] Go back", "[ctrl+c] Exit")
+	if _, busy := r.busy(); busy != "" {
+		footerItems = append(footerItems, footerItem(targetPress+"esc", "[esc/ctrl+c] Cancel "+busy))
+		return r.footerStyle(width).Render("💡 " + strings.Join(footerItems, " | "))
+	}
+	if !r.Capturing() {
+		footerItems = append(footerItems, footerItem(targetAction+keymap.Palette, fmt.Sprintf("[%s] Palette", r.keys.Display(keymap.Palette))))
+	}
+	if r.Capturing() && !ownsEsc {
+		footerItems = append(footerItems, footerItem(targetPress+"esc", "[esc] Go back"))
+	} else if !r.Capturing() {
+		footerItems = append(footerItems, footerItem(targetAction+keymap.Back, fmt.Sprintf("[%s] Go back", r.keys.Display(keymap.Back))))
+	}
+	footerItems = append(footerItems, footerItem(targetAction+keymap.Quit, "[ctrl+c] Exit"))
 	footerContent := "💡 " + strings.Join(footerItems, " | ")
-	return r.styles.Footer.Width(width - 4).Render(footerContent)
+	return r.footerStyle(width).Render(footerContent)
 }
 func (r *Router) RenderStatusBar(width int) string {
 	breadcrumb := r.GetBreadcrumb()
 	statusContent := fmt.Sprintf("📍 %s", breadcrumb)
-	return r.styles.StatusBar.Width(width - 4).Render(statusContent)
+	if r.profile != "" {
+		statusContent += fmt.Sprintf("  👤 %s", r.profile)
+	}
+	if running := r.jobs.Running(); running > 0 {
+		statusContent += fmt.Sprintf("  ⏳ %d running", running)
+	}
+	if pending := r.keys.PendingKeys(); pending != "" {
+		statusContent += fmt.Sprintf("  ⌨️  %s …", pending)
+	}
+	return r.styles.StatusBar.Width(width).MaxHeight(1).Render(statusContent)
 }
 func (r *Router) GetBreadcrumb() string {
 	if r.currentRoute == "/" {
@@ -206,6 +342,12 @@ func (r *Router) GetBreadcrumb() string {
 	parts := strings.Split(strings.Trim(r.currentRoute, "/"), "/")
 	return strings.Join(parts, " > ")
 }
+func (r *Router) Jobs() *jobs.Manager {
+	return r.jobs
+}
+func (r *Router) SetProfile(profile string) {
+	r.profile = profile
+}
 func (r *Router) UpdateTheme(newTheme *theme.Theme) {
 	r.theme = newTheme
 	r.styles = NewRouterStyles(newTheme)
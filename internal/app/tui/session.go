@@ -0,0 +1,116 @@
+package tui
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/viewport"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/session"
+)
+const restoreModal = "tui:restore"
+func (r *Router) Snapshot() *session.Session {
+	s := &session.Session{
+		Route:   r.currentRoute,
+		History: append([]string(nil), r.history...),
+		Scroll:  make(map[string]int),
+		Drafts:  make(map[string]url.Values),
+	}
+	for path, v := range r.viewports {
+		if offset := v.Offset(); offset > 0 {
+			s.Scroll[path] = offset
+		}
+	}
+	for _, route := range r.sortedRoutes() {
+		if drafter, ok := route.Component.(types.Drafter); ok {
+			if draft := drafter.Draft(); len(draft) > 0 {
+				s.Drafts[route.Path] = draft
+			}
+		}
+	}
+	return s
+}
+func (r *Router) Restore(s *session.Session) (tea.Cmd, error) {
+	var errs []error
+	for path, draft := range s.Drafts {
+		route, ok := r.routes[path]
+		if !ok {
+			continue
+		}
+		if drafter, ok := route.Component.(types.Drafter); ok {
+			if err := drafter.RestoreDraft(draft); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", route.Title, err))
+			}
+		}
+	}
+	var cmd tea.Cmd
+	if s.Route != "" && s.Route != r.currentRoute {
+		var err error
+		if cmd, err = r.NavigateTo(s.Route); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	r.history = r.history[:0]
+	for _, path := range s.History {
+		if _, ok := r.routes[path]; ok && path != r.currentRoute {
+			r.history = append(r.history, path)
+		}
+	}
+	for path, offset := range s.Scroll {
+		if _, ok := r.routes[path]; !ok {
+			continue
+		}
+		if _, ok := r.viewports[path]; !ok {
+			r.viewports[path] = viewport.New()
+		}
+		r.viewports[path].SetOffset(offset)
+	}
+	return cmd, errors.Join(errs...)
+}
+func (m *Model) restorePrompt() tea.Cmd {
+	if m.session == nil || m.session.Empty() {
+		return nil
+	}
+	where := m.session.Route
+	if route, ok := m.router.GetAllRoutes()[where]; ok {
+		where = route.Title
+	}
+	message := fmt.Sprintf("Saved %s on %s.", m.session.SavedAt.Format("Jan 2 15:04"), where)
+	var drafts []string
+	for path := range m.session.Drafts {
+		if route, ok := m.router.GetAllRoutes()[path]; ok {
+			drafts = append(drafts, route.Title)
+		}
+	}
+	if len(drafts) > 0 {
+		message += " Unfinished: " + strings.Join(drafts, ", ") + "."
+	}
+	return overlay.Confirm(restoreModal, "Restore previous session?", message)
+}
+func (m *Model) restoreSession(restore bool) tea.Cmd {
+	s := m.session
+	m.session = nil
+	if !restore || s == nil {
+		return nil
+	}
+	if s.Theme != "" && configfile.OriginOf("theme").Origin == configfile.OriginDefault {
+		m.setTheme(theme.ByName(s.Theme))
+	}
+	cmd, err := m.router.Restore(s)
+	if err != nil {
+		return tea.Batch(cmd, m.toasts.Push(overlay.Warning, "⚠️  Session partly restored: "+err.Error()))
+	}
+	return tea.Batch(cmd, m.toasts.Push(overlay.Info, "↩️  Session restored"))
+}
+func (m *Model) saveSession() error {
+	s := m.router.Snapshot()
+	if s.Empty() && m.session != nil {
+		return nil
+	}
+	s.Theme = strings.ToLower(m.theme.Name)
+	return session.Save(s)
+}
@@ -0,0 +1,79 @@
+package tui
+import (
+	"errors"
+	"net/url"
+	"os"
+	"testing"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+	"github.com/danielscoffee/dev-tools/internal/pkg/session"
+)
+type draftPage struct {
+	stubPage
+	draft url.Values
+}
+func (p *draftPage) Draft() url.Values {
+	return p.draft
+}
+func (p *draftPage) RestoreDraft(draft url.Values) error {
+	if draft.Has("broken") {
+		return errors.New("cannot restore")
+	}
+	p.draft = draft
+	return nil
+}
+func TestSnapshotRestore(t *testing.T) {
+	r := newTestRouter()
+	form := &draftPage{stubPage: stubPage{title: "Blueprint"}, draft: url.Values{"name": {"api"}}}
+	r.RegisterRoute("/langs/golang/blueprint", form, "Blueprint", "", "b")
+	r.NavigateTo("/langs")
+	r.NavigateTo("/langs/golang")
+	r.viewport().SetOffset(4)
+	s := r.Snapshot()
+	if s.Route != "/langs/golang" || len(s.History) != 2 || s.Scroll["/langs/golang"] != 4 || s.Drafts["/langs/golang/blueprint"].Get("name") != "api" {
+		t.Fatalf("Snapshot() = %+v", s)
+	}
+	restored := newTestRouter()
+	page := &draftPage{stubPage: stubPage{title: "Blueprint"}}
+	restored.RegisterRoute("/langs/golang/blueprint", page, "Blueprint", "", "b")
+	s.History = append(s.History, "/removed")
+	if _, err := restored.Restore(s); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if restored.GetCurrentRoute().Path != "/langs/golang" || len(restored.history) != 2 || page.draft.Get("name") != "api" {
+		t.Errorf("Restore() left %s with history %v and draft %v", restored.GetCurrentRoute().Path, restored.history, page.draft)
+	}
+	if restored.viewport().Offset() != 4 {
+		t.Errorf("scroll offset = %d, want 4", restored.viewport().Offset())
+	}
+	_, err := newTestRouter().Restore(&session.Session{Route: "/doctor"})
+	if err == nil {
+		t.Error("Restore() should report a route whose guard fails")
+	}
+	broken := newTestRouter()
+	broken.RegisterRoute("/langs/golang/blueprint", &draftPage{}, "Blueprint", "", "b")
+	if _, err := broken.Restore(&session.Session{Drafts: map[string]url.Values{"/langs/golang/blueprint": {"broken": {"1"}}}}); err == nil {
+		t.Error("Restore() should report a draft the page rejects")
+	}
+}
+func TestRestoreSessionTheme(t *testing.T) {
+	for env, want := range map[string]string{"": "Themeless", "dark": "Custom"} {
+		t.Setenv("DEV_TOOLS_THEME", env)
+		if env == "" {
+			os.Unsetenv("DEV_TOOLS_THEME")
+		}
+		m := &Model{
+			router:  newTestRouter(),
+			theme:   &theme.Theme{Name: "Custom"},
+			toasts:  overlay.NewToasts(theme.NewStyles(theme.Dark())),
+			session: &session.Session{Route: "/langs", Theme: "light"},
+		}
+		m.restoreSession(true)
+		if m.theme.Name != want {
+			t.Errorf("theme with DEV_TOOLS_THEME=%q = %s, want %s", env, m.theme.Name, want)
+		}
+		if m.router.GetCurrentRoute().Path != "/langs" || m.session != nil {
+			t.Errorf("restoreSession() left %s with session %v", m.router.GetCurrentRoute().Path, m.session)
+		}
+	}
+}
@@ -1,5 +1,8 @@
 package theme
-import "github.com/charmbracelet/lipgloss"
+import (
+	"strings"
+	"github.com/charmbracelet/lipgloss"
+)
 type Theme struct {
 	Name       string
 	Primary    lipgloss.Color
@@ -34,6 +37,18 @@ func Dark() *Theme {
 func Light() *Theme {
 	return Themeless()
 }
+func Names() []string {
+	return []string{"themeless", "dark", "light"}
+}
+func ByName(name string) *Theme {
+	switch strings.ToLower(name) {
+	case "dark":
+		return Dark()
+	case "light":
+		return Light()
+	}
+	return Themeless()
+}
 func (t *Theme) GetResetSequence() string {
 	return "\033[0m"
 }
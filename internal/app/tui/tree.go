@@ -0,0 +1,131 @@
+package tui
+import (
+	"errors"
+	"os/exec"
+	"sort"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/keymap"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+)
+type KeyScope int
+const (
+	ScopeParent KeyScope = iota
+	ScopeGlobal
+)
+type Guard func() error
+func RequireCommand(name, hint string) Guard {
+	return func() error {
+		if _, err := exec.LookPath(name); err != nil {
+			return errors.New("requires " + name + " on PATH, " + hint)
+		}
+		return nil
+	}
+}
+func (rt *Route) WithGuard(guard Guard) *Route {
+	rt.Guard = guard
+	return rt
+}
+func (rt *Route) WithScope(scope KeyScope) *Route {
+	rt.Scope = scope
+	return rt
+}
+func (rt *Route) Blocked() error {
+	if rt.Guard == nil {
+		return nil
+	}
+	return rt.Guard()
+}
+func routeParent(path string) string {
+	if path == "/" {
+		return ""
+	}
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		return path[:i]
+	}
+	return "/"
+}
+func (r *Router) sortedRoutes() []*Route {
+	routes := make([]*Route, 0, len(r.routes))
+	for _, route := range r.routes {
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].order < routes[j].order
+	})
+	return routes
+}
+func (r *Router) childRoutes(path string) []*Route {
+	var children []*Route
+	for _, route := range r.sortedRoutes() {
+		if route.Parent == path {
+			children = append(children, route)
+		}
+	}
+	return children
+}
+func (r *Router) Children(path string) []types.MenuEntry {
+	var entries []types.MenuEntry
+	for _, route := range r.childRoutes(path) {
+		entry := types.MenuEntry{
+			Path:        route.Path,
+			Action:      route.Action,
+			Title:       route.Title,
+			Description: route.Description,
+			Key:         route.KeyBinding,
+		}
+		if err := route.Blocked(); err != nil {
+			entry.Blocked = err.Error()
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+func (r *Router) availableFrom(path string) []*Route {
+	available := r.childRoutes(path)
+	for _, route := range r.sortedRoutes() {
+		if route.Scope == ScopeGlobal && route.Parent != path {
+			available = append(available, route)
+		}
+	}
+	return available
+}
+func (r *Router) navigate(path string) tea.Cmd {
+	cmd, err := r.NavigateTo(path)
+	if err != nil {
+		return func() tea.Msg {
+			return types.NavigationErrorMsg{Err: err}
+		}
+	}
+	return cmd
+}
+func (r *Router) KeyConflict(path, key string) (string, bool) {
+	if key == "ctrl+c" {
+		return "a global shortcut", true
+	}
+	for _, action := range []string{keymap.Quit, keymap.Back, keymap.Palette} {
+		if contains(r.keys.Keys(action), key) {
+			return "the global " + action + " shortcut", true
+		}
+	}
+	target, ok := r.routes[path]
+	if !ok {
+		return "", false
+	}
+	for _, route := range r.routes {
+		if route.Path == path || route.KeyBinding != key {
+			continue
+		}
+		if route.Parent == target.Parent || route.Scope == ScopeGlobal || target.Scope == ScopeGlobal {
+			return route.Action, true
+		}
+	}
+	if parent, ok := r.routes[target.Parent]; ok {
+		for _, kb := range parent.Component.GetKeyBindings() {
+			if kb.Key == key && !strings.HasPrefix(kb.Action, "navigate_") {
+				return kb.Action + " on " + parent.Title, true
+			}
+		}
+	}
+	return "", false
+}
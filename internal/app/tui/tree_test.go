@@ -0,0 +1,221 @@
+package tui
+import (
+	"errors"
+	"net/url"
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/plugins"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/pkg/plugin"
+)
+type stubPage struct {
+	title    string
+	bindings []types.KeyBinding
+	keys     []string
+}
+func (p *stubPage) Render(width, height int) string {
+	return p.title
+}
+func (p *stubPage) HandleInput(msg tea.KeyMsg) (bool, tea.Cmd) {
+	p.keys = append(p.keys, msg.String())
+	return true, nil
+}
+func (p *stubPage) GetTitle() string {
+	return p.title
+}
+func (p *stubPage) GetKeyBindings() []types.KeyBinding {
+	return p.bindings
+}
+func newTestRouter() *Router {
+	r := NewRouter()
+	r.RegisterRoute("/", &stubPage{title: "Home", bindings: []types.KeyBinding{{Key: "r", Description: "Refresh", Action: "refresh"}}}, "Home", "", "h")
+	r.RegisterRoute("/langs", &stubPage{title: "Languages"}, "Languages", "", "l")
+	r.RegisterRoute("/langs/golang", &stubPage{title: "Go"}, "Go", "", "g")
+	configRoute, _ := r.RegisterRoute("/config", &stubPage{title: "Config"}, "Config", "", "c")
+	configRoute.WithScope(ScopeGlobal)
+	doctorRoute, _ := r.RegisterRoute("/doctor", &stubPage{title: "Doctor"}, "Doctor", "", "d")
+	doctorRoute.WithGuard(func() error {
+		return errors.New("requires go on PATH")
+	})
+	return r
+}
+func TestRouteTreeNames(t *testing.T) {
+	tests := []struct {
+		path   string
+		action string
+		parent string
+	}{
+		{path: "/", action: "navigate_home", parent: ""},
+		{path: "/langs", action: "navigate_langs", parent: "/"},
+		{path: "/langs/golang", action: "navigate_langs_golang", parent: "/langs"},
+		{path: "/config/theme", action: "navigate_config_theme", parent: "/config"},
+		{path: "/plugins/deploy/status", action: "navigate_plugins_deploy_status", parent: "/plugins/deploy"},
+	}
+	for _, tt := range tests {
+		if got := types.RouteAction(tt.path); got != tt.action {
+			t.Errorf("RouteAction(%q) = %q, want %q", tt.path, got, tt.action)
+		}
+		if got := routeParent(tt.path); got != tt.parent {
+			t.Errorf("routeParent(%q) = %q, want %q", tt.path, got, tt.parent)
+		}
+	}
+}
+func TestAvailableRoutes(t *testing.T) {
+	r := newTestRouter()
+	paths := func(from string) []string {
+		var out []string
+		for _, route := range r.availableFrom(from) {
+			out = append(out, route.Path)
+		}
+		return out
+	}
+	if got := paths("/"); len(got) != 3 || got[0] != "/langs" || got[1] != "/config" || got[2] != "/doctor" {
+		t.Errorf("availableRoutes() from / = %v, want [/langs /config /doctor]", got)
+	}
+	if got := paths("/langs"); len(got) != 2 || got[0] != "/langs/golang" || got[1] != "/config" {
+		t.Errorf("availableRoutes() from /langs = %v, want the child plus the global route", got)
+	}
+}
+func TestGuards(t *testing.T) {
+	r := newTestRouter()
+	if _, err := r.NavigateTo("/doctor"); err == nil {
+		t.Fatal("NavigateTo() should refuse a guarded route")
+	}
+	if r.GetCurrentRoute().Path != "/" {
+		t.Errorf("current route = %s, want / after a blocked navigation", r.GetCurrentRoute().Path)
+	}
+	handled, cmd := r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if !handled || cmd == nil {
+		t.Fatal("HandleInput() should report a blocked route")
+	}
+	if msg, ok := cmd().(types.NavigationErrorMsg); !ok || msg.Err == nil {
+		t.Errorf("cmd() = %#v, want a NavigationErrorMsg", msg)
+	}
+	for _, entry := range r.Children("/") {
+		if blocked := entry.Blocked != ""; blocked != (entry.Path == "/doctor") {
+			t.Errorf("Children entry %s Blocked = %q", entry.Path, entry.Blocked)
+		}
+	}
+}
+func TestHandleInputRoutesKeys(t *testing.T) {
+	r := newTestRouter()
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if r.GetCurrentRoute().Path != "/" {
+		t.Fatal("a grandchild key should not navigate from /")
+	}
+	if page := r.routes["/"].Component.(*stubPage); len(page.keys) != 1 || page.keys[0] != "g" {
+		t.Errorf("unmatched keys should reach the page, got %v", page.keys)
+	}
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	if got := r.GetBreadcrumb(); got != "langs > golang" {
+		t.Errorf("GetBreadcrumb() = %q, want langs > golang", got)
+	}
+	r.HandleInput(tea.KeyMsg{Type: tea.KeyEsc})
+	if r.GetCurrentRoute().Path != "/langs" {
+		t.Errorf("esc should go back to /langs, got %s", r.GetCurrentRoute().Path)
+	}
+}
+func TestKeyConflict(t *testing.T) {
+	r := newTestRouter()
+	tests := []struct {
+		path string
+		key  string
+		want string
+		ok   bool
+	}{
+		{path: "/langs", key: "ctrl+c", want: "a global shortcut", ok: true},
+		{path: "/langs", key: "q", want: "the global quit shortcut", ok: true},
+		{path: "/langs", key: "d", want: "navigate_doctor", ok: true},
+		{path: "/langs/golang", key: "c", want: "navigate_config", ok: true},
+		{path: "/langs/golang", key: "d", ok: false},
+		{path: "/langs", key: "r", want: "refresh on Home", ok: true},
+		{path: "/missing", key: "x", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := r.KeyConflict(tt.path, tt.key)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("KeyConflict(%s, %s) = (%q, %v), want (%q, %v)", tt.path, tt.key, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+func TestApplyKeymap(t *testing.T) {
+	r := newTestRouter()
+	r.ApplyKeymap("", map[string]string{"navigate_langs": "L"})
+	if r.routes["/langs"].KeyBinding != "L" || r.routes["/config"].KeyBinding != "c" {
+		t.Fatalf("ApplyKeymap() did not rebind only navigate_langs")
+	}
+	r.ApplyKeymap("", nil)
+	if r.routes["/langs"].KeyBinding != "l" {
+		t.Error("ApplyKeymap(nil) should restore the default key")
+	}
+}
+func TestRegisterRouteRejectsDuplicateActions(t *testing.T) {
+	r := newTestRouter()
+	if _, err := r.RegisterRoute("/langs_golang", &stubPage{title: "Clash"}, "Clash", "", "x"); !errors.Is(err, ErrDuplicateAction) {
+		t.Fatalf("RegisterRoute(/langs_golang) error = %v, want ErrDuplicateAction", err)
+	}
+	if _, ok := r.routes["/langs_golang"]; ok {
+		t.Error("a rejected route should not be registered")
+	}
+	if _, err := r.RegisterRoute("/langs/golang", &stubPage{title: "Go"}, "Go", "", "G"); err != nil {
+		t.Fatalf("re-registering /langs/golang error = %v", err)
+	}
+	if route := r.routeByAction("navigate_langs_golang"); route == nil || route.Path != "/langs/golang" || route.KeyBinding != "G" {
+		t.Errorf("routeByAction(navigate_langs_golang) = %+v", route)
+	}
+	if route := r.routeByAction("navigate_golang"); route != nil {
+		t.Errorf("routeByAction(navigate_golang) = %s, want nil", route.Path)
+	}
+}
+func TestAddPluginsSkipsDuplicateActions(t *testing.T) {
+	r := newTestRouter()
+	index := plugins.NewIndexPage()
+	r.RegisterRoute("/plugins", index, "Plugins", "", "p")
+	m := &Model{router: r, toasts: overlay.NewToasts(theme.NewStyles(theme.Themeless()))}
+	cmd := m.addPlugins([]plugins.Entry{
+		{Path: "/plugins/a_b/c", Info: plugin.PageInfo{ID: "c", Title: "First"}, Key: "1"},
+		{Path: "/plugins/a/b_c", Info: plugin.PageInfo{ID: "b_c", Title: "Second"}, Key: "2"},
+	})
+	if cmd == nil {
+		t.Error("addPlugins() should warn about the skipped page")
+	}
+	if _, ok := r.routes["/plugins/a/b_c"]; ok {
+		t.Error("the clashing plugin page should not be registered")
+	}
+	if bindings := index.GetKeyBindings(); len(bindings) != 1 || bindings[0].Action != "navigate_plugins_a_b_c" {
+		t.Errorf("index bindings = %+v", bindings)
+	}
+}
+type paramPage struct {
+	stubPage
+	params url.Values
+}
+func (p *paramPage) SetParams(params url.Values) error {
+	if params.Has("fail") {
+		return errors.New("bad parameter")
+	}
+	p.params = params
+	return nil
+}
+func TestNavigateToWithParams(t *testing.T) {
+	r := newTestRouter()
+	page := &paramPage{stubPage: stubPage{title: "Blueprint"}}
+	r.RegisterRoute("/langs/golang/blueprint", page, "Blueprint", "", "b")
+	if _, err := r.NavigateTo("/langs/golang/blueprint?framework=gin&features=htmx&features=docker"); err != nil {
+		t.Fatalf("NavigateTo() error = %v", err)
+	}
+	if page.params.Get("framework") != "gin" || len(page.params["features"]) != 2 {
+		t.Errorf("params = %v", page.params)
+	}
+	for _, target := range []string{"/langs/golang/blueprint?fail=1", "/langs?name=x", "/langs/golang/blueprint?%zz", "/missing?name=x"} {
+		if _, err := r.NavigateTo(target); err == nil {
+			t.Errorf("NavigateTo(%q) should fail", target)
+		}
+	}
+	if r.GetCurrentRoute().Path != "/langs/golang/blueprint" {
+		t.Errorf("a failed navigation moved to %s", r.GetCurrentRoute().Path)
+	}
+}
@@ -1,6 +1,10 @@
 // Package types
 package types
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"net/url"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+)
 type KeyBinding struct {
 	Key         string
 	Description string
@@ -12,3 +16,65 @@ type PageRenderer interface {
 	GetTitle() string
 	GetKeyBindings() []KeyBinding
 }
+type Initializer interface {
+	Init() tea.Cmd
+}
+type Updater interface {
+	Update(msg tea.Msg) tea.Cmd
+}
+type Enterer interface {
+	OnEnter() tea.Cmd
+}
+type Leaver interface {
+	OnLeave() tea.Cmd
+}
+type Resizer interface {
+	Resize(width, height int)
+}
+type InputCapturer interface {
+	CapturesInput() bool
+}
+type MouseHandler interface {
+	HandleMouse(msg tea.MouseMsg, zone string) tea.Cmd
+}
+type Canceler interface {
+	Busy() string
+	Cancel() tea.Cmd
+}
+type ParamReceiver interface {
+	SetParams(params url.Values) error
+}
+type ProjectOpener interface {
+	OpenProject(dir string)
+}
+type Drafter interface {
+	Draft() url.Values
+	RestoreDraft(draft url.Values) error
+}
+type RouteBinding struct {
+	Path       string
+	Title      string
+	Action     string
+	Key        string
+	DefaultKey string
+}
+type MenuEntry struct {
+	Path        string
+	Action      string
+	Title       string
+	Description string
+	Key         string
+	Blocked     string
+}
+type NavigateMsg struct {
+	Path string
+}
+type NavigationErrorMsg struct {
+	Err error
+}
+func RouteAction(path string) string {
+	if path == "/" {
+		return "navigate_home"
+	}
+	return "navigate_" + strings.ReplaceAll(strings.Trim(path, "/"), "/", "_")
+}
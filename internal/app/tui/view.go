@@ -1,23 +1,48 @@
 package tui
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/jobs"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/keymap"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/overlay"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/config"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/help"
+	jobspage "github.com/danielscoffee/dev-tools/internal/app/tui/pages/jobs"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/home"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/langs"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/langs/golang"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/langs/golang/blueprint"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/langs/golang/watch"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/plugins"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/pages/tasks"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/process"
 	"github.com/danielscoffee/dev-tools/internal/app/tui/theme"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/types"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+	"github.com/danielscoffee/dev-tools/internal/pkg/session"
 )
+const shutdownTimeout = 3 * time.Second
+var ErrStartRoute = errors.New("cannot open")
 type Model struct {
-	router *Router
-	ready  bool
-	width  int
-	height int
-	styles *AppStyles
-	theme  *theme.Theme
+	router        *Router
+	ready         bool
+	width         int
+	height        int
+	styles        *AppStyles
+	theme         *theme.Theme
+	configChanges chan string
+	palette       *Palette
+	modals        []*overlay.Modal
+	toasts        *overlay.Toasts
+	session       *session.Session
+	routeErrs     []string
+}
+type configFileMsg struct {
+	path string
 }
 type AppStyles struct {
 	App lipgloss.Style
@@ -27,18 +52,62 @@ func NewModel() *Model {
 }
 func NewModelWithTheme(themeName string) *Model {
 	router := NewRouter()
-	currentTheme := theme.Themeless()
-	router.RegisterRoute("/", home.NewPage(), "Dev Tools - Home", "Main menu and navigation", "h")
-	router.RegisterRoute("/langs", langs.NewPage(), "Programming Languages", "Tools for different languages", "l")
-	router.RegisterRoute("/langs/golang", golang.NewPage(), "Go/Golang Tools", "Go development tools", "g")
-	router.RegisterRoute("/langs/golang/blueprint", blueprint.NewPage(), "Go Blueprint Creator", "Create Go projects with go-blueprint", "b")
-	router.RegisterRoute("/config", config.NewPage(), "Configuration", "Application settings", "c")
-	router.RegisterRoute("/help", help.NewPage(), "Help & Documentation", "Usage instructions and help", "?")
-	return &Model{
-		router: router,
-		styles: NewAppStyles(currentTheme),
-		theme:  currentTheme,
+	currentTheme := theme.ByName(themeName)
+	router.UpdateTheme(currentTheme)
+	requireGo := RequireCommand("go", "install it from https://go.dev/dl")
+	var routeErrs []string
+	add := func(route *Route, err error) *Route {
+		if err != nil {
+			routeErrs = append(routeErrs, err.Error())
+			return &Route{}
+		}
+		return route
+	}
+	add(router.RegisterRoute("/", home.NewPage(router, "/"), "Dev Tools - Home", "Main menu and navigation", "h"))
+	add(router.RegisterRoute("/langs", langs.NewPage(router, "/langs"), "Programming Languages", "Tools for different languages", "l"))
+	add(router.RegisterRoute("/langs/golang", golang.NewPage(router, "/langs/golang"), "Go/Golang Tools", "Go development tools", "g"))
+	add(router.RegisterRoute("/langs/golang/blueprint", blueprint.NewPage(), "Go Blueprint Creator", "Create Go projects with go-blueprint", "b")).WithGuard(requireGo)
+	add(router.RegisterRoute("/langs/golang/watch", watch.NewPage(), "Go Watch & Reload", "Rebuild and restart a Go service on change", "w")).WithGuard(requireGo)
+	add(router.RegisterRoute("/tasks", tasks.NewPage(), "Tasks", "Run tasks from the project's .dev-tools.yaml", "r"))
+	add(router.RegisterRoute("/jobs", jobspage.NewPage(router.Jobs()), "Jobs", "Background jobs with their status and logs", "b"))
+	add(router.RegisterRoute("/config", config.NewPage(router, "/config"), "Configuration", "Application settings", "c"))
+	add(router.RegisterRoute("/config/theme", config.NewThemePage(), "Theme", "List and preview themes", "t"))
+	add(router.RegisterRoute("/config/keys", config.NewKeysPage(router), "Keybindings", "Rebind navigation shortcuts", "k"))
+	add(router.RegisterRoute("/config/paths", config.NewPathsPage(), "Paths", "Default project directory and GOBIN", "p"))
+	add(router.RegisterRoute("/config/reset", config.NewResetPage(), "Reset", "Restore the default settings", "r"))
+	add(router.RegisterRoute("/plugins", plugins.NewIndexPage(), "Plugins", "Pages contributed by dev-tools-* plugins", "p"))
+	add(router.RegisterRoute("/help", help.NewPage(router), "Help & Documentation", "Usage instructions and help", "?")).WithScope(ScopeGlobal)
+	router.ApplyKeymap(configfile.Current().KeymapPreset, configfile.Current().Keymap)
+	profile, _ := configfile.ActiveProfile()
+	router.SetProfile(profile)
+	m := &Model{
+		router:    router,
+		styles:    NewAppStyles(currentTheme),
+		theme:     currentTheme,
+		toasts:    overlay.NewToasts(theme.NewStyles(currentTheme)),
+		routeErrs: routeErrs,
+	}
+	router.HandleAction(keymap.Palette, m.openPalette)
+	router.HandleAction(keymap.Theme, m.toggleTheme)
+	return m
+}
+func (m *Model) openPalette() tea.Cmd {
+	m.palette = NewPalette(m.paletteItems(), m.theme)
+	return nil
+}
+func (m *Model) toggleTheme() tea.Cmd {
+	if m.theme.Name == "Dark" {
+		m.setTheme(theme.Light())
+	} else {
+		m.setTheme(theme.Dark())
 	}
+	return nil
+}
+func (m *Model) setTheme(t *theme.Theme) {
+	m.theme = t
+	m.styles = NewAppStyles(m.theme)
+	m.router.UpdateTheme(m.theme)
+	m.toasts.SetStyles(theme.NewStyles(m.theme))
 }
 func NewAppStyles(t *theme.Theme) *AppStyles {
 	return &AppStyles{
@@ -49,7 +118,39 @@ func NewAppStyles(t *theme.Theme) *AppStyles {
 	}
 }
 func (m *Model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.waitForConfigChange(), m.router.Init(), m.router.Jobs().Listen(), plugins.Discover(), m.keymapNotice(), m.routeNotice(), m.restorePrompt())
+}
+func (m *Model) keymapNotice() tea.Cmd {
+	conflicts := m.router.KeymapConflicts()
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return m.toasts.Push(overlay.Warning, "⚠️  Key conflicts: "+strings.Join(conflicts, "; "))
+}
+func (m *Model) routeNotice() tea.Cmd {
+	if len(m.routeErrs) == 0 {
+		return nil
+	}
+	return m.toasts.Push(overlay.Error, "❌ Pages not registered: "+strings.Join(m.routeErrs, "; "))
+}
+func (m *Model) watchConfig() {
+	changes := make(chan string, 1)
+	m.configChanges = changes
+	configfile.Watch(func(path string) {
+		select {
+		case changes <- path:
+		default:
+		}
+	})
+}
+func (m *Model) waitForConfigChange() tea.Cmd {
+	changes := m.configChanges
+	if changes == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		return configFileMsg{path: <-changes}
+	}
 }
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -57,64 +158,204 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 		m.ready = true
-		return m, nil
+		m.router.SetCompact(msg.Width < compactWidth || msg.Height < compactHeight)
+		l := m.layout()
+		m.router.Resize(l.width, l.height)
+		return m, m.router.Update(msg)
 	case tea.KeyMsg:
-		if msg.String() == "t" {
-			if m.theme.Name == "Dark" {
-				m.theme = theme.Light()
-			} else {
-				m.theme = theme.Dark()
+		if msg.String() == "ctrl+c" && (len(m.modals) > 0 || m.palette != nil) {
+			return m, tea.Quit
+		}
+		if len(m.modals) > 0 {
+			if done, cmd := m.modals[0].HandleInput(msg); done {
+				m.modals = m.modals[1:]
+				return m, cmd
 			}
-			m.styles = NewAppStyles(m.theme)
-			m.router.UpdateTheme(m.theme)
 			return m, nil
 		}
-		if msg.String() == "?" {
+		if m.palette != nil {
+			closed, selected := m.palette.HandleInput(msg)
+			if closed {
+				m.palette = nil
+			}
+			if selected != nil {
+				return m, selected.Run(m)
+			}
+			return m, nil
+		}
+		_, cmd := m.router.HandleInput(msg)
+		return m, cmd
+	case tea.MouseMsg:
+		if m.palette != nil || len(m.modals) > 0 {
 			return m, nil
 		}
-		if cont, cmd := m.router.HandleInput(msg); !cont {
-			return m, cmd
+		return m, m.router.HandleMouse(msg)
+	case types.NavigateMsg:
+		cmd, err := m.router.NavigateTo(msg.Path)
+		if err != nil {
+			return m, m.toasts.Push(overlay.Error, "🔒 "+err.Error())
 		}
+		return m, cmd
+	case types.NavigationErrorMsg:
+		return m, m.toasts.Push(overlay.Error, "🔒 "+msg.Err.Error())
+	case config.ChangedMsg:
+		m.applyConfig()
 		return m, nil
-	default:
-		currentRoute := m.router.GetCurrentRoute()
-		if currentRoute != nil && currentRoute.Path == "/langs/golang/blueprint" {
-			if blueprintPage, ok := currentRoute.Component.(*blueprint.Page); ok {
-				return m, blueprintPage.Update(msg)
+	case configFileMsg:
+		if err := configfile.ReloadFile(msg.path); err != nil {
+			return m, tea.Batch(m.waitForConfigChange(), m.toasts.Push(overlay.Error, "❌ Config not reloaded, "+msg.path+": "+err.Error()))
+		}
+		m.applyConfig()
+		return m, tea.Batch(m.waitForConfigChange(), m.toasts.Push(overlay.Info, "🔄 Reloaded "+msg.path))
+	case jobs.StartMsg:
+		job := m.router.Jobs().Start(msg.Owner, msg.Title, msg.Run)
+		return m, m.router.Update(jobs.StartedMsg{ID: job.ID, Owner: job.Owner})
+	case jobs.EventMsg:
+		cmds := []tea.Cmd{m.router.Jobs().Listen(), m.router.Update(msg.Msg)}
+		if done, ok := msg.Msg.(jobs.DoneMsg); ok {
+			cmds = append(cmds, m.jobNotice(done))
+		}
+		return m, tea.Batch(cmds...)
+	case process.DoneMsg:
+		return m, tea.Batch(m.router.Update(msg), m.processNotice(msg))
+	case plugins.LoadedMsg:
+		return m, m.addPlugins(msg.Entries)
+	case jobs.CancelMsg:
+		m.router.Jobs().Cancel(msg.ID)
+		return m, nil
+	case overlay.ResultMsg:
+		switch msg.ID {
+		case cancelModal:
+			if !msg.OK {
+				return m, nil
 			}
+			return m, m.router.CancelBusy()
+		case restoreModal:
+			return m, m.restoreSession(msg.OK)
 		}
+		return m, m.router.Update(msg)
+	case overlay.ModalMsg:
+		m.modals = append(m.modals, overlay.NewModal(msg, theme.NewStyles(m.theme)))
+		return m, nil
+	case overlay.ToastMsg:
+		return m, m.toasts.Push(msg.Severity, msg.Text)
+	}
+	if handled, cmd := m.toasts.Update(msg); handled {
+		return m, cmd
+	}
+	return m, m.router.Update(msg)
+}
+func (m *Model) addPlugins(entries []plugins.Entry) tea.Cmd {
+	var added []plugins.Entry
+	var skipped []string
+	for _, entry := range entries {
+		if _, err := m.router.RegisterRoute(entry.Path, plugins.NewPage(entry), entry.Info.Title, entry.Info.Description, entry.Key); err != nil {
+			skipped = append(skipped, err.Error())
+			continue
+		}
+		added = append(added, entry)
+	}
+	if index, ok := m.router.GetAllRoutes()["/plugins"].Component.(*plugins.IndexPage); ok {
+		index.SetEntries(added)
+	}
+	cfg := configfile.Current()
+	m.router.ApplyKeymap(cfg.KeymapPreset, cfg.Keymap)
+	if m.ready {
+		l := m.layout()
+		m.router.Resize(l.width, l.height)
+	}
+	if len(skipped) == 0 {
+		return nil
+	}
+	return m.toasts.Push(overlay.Warning, "⚠️  Plugin pages skipped: "+strings.Join(skipped, "; "))
+}
+func (m *Model) applyConfig() {
+	cfg := configfile.Current()
+	cfg.ApplyEnv()
+	m.theme = theme.ByName(cfg.Theme)
+	m.styles = NewAppStyles(m.theme)
+	m.router.UpdateTheme(m.theme)
+	m.toasts.SetStyles(theme.NewStyles(m.theme))
+	m.router.ApplyKeymap(cfg.KeymapPreset, cfg.Keymap)
+	profile, _ := configfile.ActiveProfile()
+	m.router.SetProfile(profile)
+	if blueprintPage, ok := m.router.GetAllRoutes()["/langs/golang/blueprint"].Component.(*blueprint.Page); ok {
+		blueprintPage.ApplyConfig()
+	}
+}
+func (m *Model) jobNotice(done jobs.DoneMsg) tea.Cmd {
+	switch done.Status {
+	case jobs.StatusSucceeded:
+		return m.toasts.Push(overlay.Success, fmt.Sprintf("✅ Job #%d %s finished", done.ID, done.Title))
+	case jobs.StatusCanceled:
+		return m.toasts.Push(overlay.Warning, fmt.Sprintf("⏹️  Job #%d %s canceled", done.ID, done.Title))
 	}
-	return m, nil
+	return m.toasts.Push(overlay.Error, fmt.Sprintf("❌ Job #%d %s failed: %v", done.ID, done.Title, done.Err))
+}
+func (m *Model) processNotice(done process.DoneMsg) tea.Cmd {
+	switch {
+	case done.Success():
+		return nil
+	case done.ExitCode > 0:
+		return m.toasts.Push(overlay.Error, fmt.Sprintf("❌ %s exited with status %d", done.Command, done.ExitCode))
+	}
+	return m.toasts.Push(overlay.Error, fmt.Sprintf("❌ %s: %v", done.Command, done.Err))
+}
+func (m *Model) close() error {
+	var err error
+	if !m.router.Jobs().CancelAll(shutdownTimeout) {
+		err = fmt.Errorf("background jobs did not stop within %s", shutdownTimeout)
+	}
+	return err
 }
 func (m *Model) View() string {
 	if !m.ready {
 		return "Loading..."
 	}
-	header := m.router.RenderHeader(m.width)
-	content := m.router.RenderCurrentPage(m.width, m.height)
-	footer := m.router.RenderFooter(m.width)
-	status := m.router.RenderStatusBar(m.width)
-	app := lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		m.router.styles.Content.Render(content),
-		footer,
-		status,
-	)
-	return m.styles.App.Render(app)
+	l := m.layout()
+	content := m.router.RenderCurrentPage(l.width, l.height)
+	if m.palette != nil {
+		content = m.palette.Render(l.width)
+	}
+	view := m.router.Scan(m.appStyle().Render(l.render(content)))
+	if !m.toasts.Empty() {
+		toasts := m.toasts.Render(l.width)
+		view = overlay.Place(view, toasts, max(0, m.width-lipgloss.Width(toasts)-2), 1)
+	}
+	if len(m.modals) > 0 {
+		view = overlay.Center(view, m.modals[0].Render(l.width), m.width, m.height)
+	}
+	return view
 }
 func Initialize() error {
 	return InitializeWithTheme("dark")
 }
 func InitializeWithTheme(themeName string) error {
+	return InitializeAt(themeName, "")
+}
+func InitializeAt(themeName, route string) error {
 	model := NewModelWithTheme(themeName)
+	if route != "" {
+		if err := model.router.Start(route); err != nil {
+			return fmt.Errorf("%w %s: %w", ErrStartRoute, route, err)
+		}
+	} else {
+		model.session, _ = session.Load()
+	}
+	model.watchConfig()
 	p := tea.NewProgram(
 		model,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
-	if _, err := p.Run(); err != nil {
+	_, err := p.Run()
+	saveErr := model.saveSession()
+	closeErr := model.close()
+	if err != nil {
 		return fmt.Errorf("failed to run TUI: %w", err)
 	}
-	return nil
+	if saveErr != nil {
+		return fmt.Errorf("failed to save session: %w", saveErr)
+	}
+	return closeErr
 }
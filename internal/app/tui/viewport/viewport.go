@@ -0,0 +1,186 @@
+// Package viewport
+package viewport
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/danielscoffee/dev-tools/internal/app/tui/zone"
+)
+const focusZone = "viewport:focus"
+var escapes = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+type Styles struct {
+	Indicator lipgloss.Style
+	Match     lipgloss.Style
+}
+type Viewport struct {
+	styles    *Styles
+	offset    int
+	height    int
+	total     int
+	focus     int
+	query     string
+	searching bool
+	matches   []int
+	match     int
+	jump      bool
+}
+func New() *Viewport {
+	return &Viewport{
+		styles: NewStyles(),
+		focus:  -1,
+	}
+}
+func NewStyles() *Styles {
+	return &Styles{
+		Indicator: lipgloss.NewStyle().
+			Faint(true),
+		Match: lipgloss.NewStyle().
+			Reverse(true),
+	}
+}
+func Focus(content string) string {
+	return zone.Mark(focusZone, content)
+}
+func (v *Viewport) body() int {
+	return max(1, v.height-1)
+}
+func (v *Viewport) clamp() {
+	v.offset = max(0, min(v.offset, v.total-v.body()))
+}
+func (v *Viewport) Render(content string, width, height int) string {
+	lines := strings.Split(content, "\n")
+	v.height, v.total = height, len(lines)
+	clip := lipgloss.NewStyle().MaxWidth(width)
+	if len(lines) <= height && !v.searching {
+		v.offset = 0
+		for i := range lines {
+			lines[i] = clip.Render(lines[i])
+		}
+		return strings.Join(lines, "\n")
+	}
+	focus := -1
+	tag := Focus("")
+	tag = tag[:len(tag)/2]
+	for i, line := range lines {
+		if strings.Contains(line, tag) {
+			focus = i
+			break
+		}
+	}
+	body := v.body()
+	if focus != v.focus && focus >= 0 {
+		if focus < v.offset {
+			v.offset = focus
+		} else if focus >= v.offset+body {
+			v.offset = focus - body + 1
+		}
+	}
+	v.focus = focus
+	v.search(lines)
+	if v.jump && len(v.matches) > 0 {
+		v.show(v.matches[v.match])
+	}
+	v.jump = false
+	v.clamp()
+	visible := make([]string, 0, height)
+	for i := v.offset; i < len(lines) && i < v.offset+body; i++ {
+		line := lines[i]
+		if v.query != "" && len(v.matches) > 0 && v.matches[v.match] == i {
+			line = v.styles.Match.Render(escapes.ReplaceAllString(line, ""))
+		}
+		visible = append(visible, clip.Render(line))
+	}
+	for len(visible) < body {
+		visible = append(visible, "")
+	}
+	return strings.Join(append(visible, clip.Render(v.indicator())), "\n")
+}
+func (v *Viewport) Offset() int {
+	return v.offset
+}
+func (v *Viewport) SetOffset(offset int) {
+	v.offset = max(0, offset)
+}
+func (v *Viewport) indicator() string {
+	if v.searching || v.query != "" {
+		status := "no matches"
+		if len(v.matches) > 0 {
+			status = fmt.Sprintf("match %d of %d", v.match+1, len(v.matches))
+		}
+		cursor := ""
+		if v.searching {
+			cursor = "█"
+		}
+		return v.styles.Indicator.Render(fmt.Sprintf("/%s%s  %s", v.query, cursor, status))
+	}
+	last := min(v.offset+v.body(), v.total)
+	return v.styles.Indicator.Render(fmt.Sprintf("── lines %d-%d of %d ──", v.offset+1, last, v.total))
+}
+func (v *Viewport) search(lines []string) {
+	v.matches = v.matches[:0]
+	if v.query == "" {
+		return
+	}
+	query := strings.ToLower(v.query)
+	for i, line := range lines {
+		if strings.Contains(strings.ToLower(escapes.ReplaceAllString(line, "")), query) {
+			v.matches = append(v.matches, i)
+		}
+	}
+	if v.match >= len(v.matches) {
+		v.match = 0
+	}
+}
+func (v *Viewport) show(line int) {
+	if line < v.offset || line >= v.offset+v.body() {
+		v.offset = line - v.body()/2
+	}
+	v.clamp()
+}
+func (v *Viewport) ScrollBy(lines int) {
+	v.offset += lines
+	v.clamp()
+}
+func (v *Viewport) PageUp() {
+	v.ScrollBy(-v.body())
+}
+func (v *Viewport) PageDown() {
+	v.ScrollBy(v.body())
+}
+func (v *Viewport) Searching() bool {
+	return v.searching
+}
+func (v *Viewport) StartSearch() {
+	v.searching = true
+	v.query = ""
+	v.match = 0
+}
+func (v *Viewport) HandleSearch(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		v.searching = false
+		v.query = ""
+		return
+	case tea.KeyEnter, tea.KeyDown, tea.KeyTab:
+		if len(v.matches) > 0 {
+			v.match = (v.match + 1) % len(v.matches)
+		}
+	case tea.KeyUp, tea.KeyShiftTab:
+		if len(v.matches) > 0 {
+			v.match = (v.match + len(v.matches) - 1) % len(v.matches)
+		}
+	case tea.KeyBackspace:
+		if runes := []rune(v.query); len(runes) > 0 {
+			v.query = string(runes[:len(runes)-1])
+			v.match = 0
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		v.query += string(msg.Runes)
+		v.match = 0
+	default:
+		return
+	}
+	v.jump = true
+}
@@ -0,0 +1,70 @@
+package viewport
+import (
+	"fmt"
+	"strings"
+	"testing"
+	tea "github.com/charmbracelet/bubbletea"
+)
+func numbered(n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	return lines
+}
+func TestRenderScrolls(t *testing.T) {
+	v := New()
+	short := v.Render("a\nb", 20, 5)
+	if short != "a\nb" {
+		t.Fatalf("Render() of short content = %q, want it unchanged", short)
+	}
+	lines := numbered(30)
+	out := strings.Split(v.Render(strings.Join(lines, "\n"), 40, 10), "\n")
+	if len(out) != 10 || out[0] != "line 1" || !strings.Contains(out[9], "lines 1-9 of 30") {
+		t.Fatalf("Render() = %q", out)
+	}
+	v.PageDown()
+	v.ScrollBy(100)
+	out = strings.Split(v.Render(strings.Join(lines, "\n"), 40, 10), "\n")
+	if out[0] != "line 22" || !strings.Contains(out[9], "lines 22-30 of 30") {
+		t.Errorf("after scrolling past the end Render() = %q", out)
+	}
+	v.PageUp()
+	v.PageUp()
+	v.PageUp()
+	if v.offset != 0 {
+		t.Errorf("offset = %d, want 0 after paging past the top", v.offset)
+	}
+}
+func TestRenderFollowsFocus(t *testing.T) {
+	v := New()
+	lines := numbered(30)
+	lines[19] = Focus(lines[19])
+	out := v.Render(strings.Join(lines, "\n"), 40, 10)
+	if !strings.Contains(out, "line 20") || strings.Contains(out, "line 11\n") {
+		t.Errorf("Render() should scroll the focused line into view, got %q", out)
+	}
+}
+func TestSearch(t *testing.T) {
+	v := New()
+	lines := numbered(30)
+	content := strings.Join(lines, "\n")
+	v.Render(content, 40, 10)
+	v.StartSearch()
+	for _, r := range "LINE 2" {
+		v.HandleSearch(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	out := v.Render(content, 40, 10)
+	if !v.Searching() || !strings.Contains(out, "/LINE 2█  match 1 of 11") {
+		t.Fatalf("indicator in %q", out)
+	}
+	v.HandleSearch(tea.KeyMsg{Type: tea.KeyUp})
+	out = v.Render(content, 40, 10)
+	if !strings.Contains(out, "match 11 of 11") || !strings.Contains(out, "line 29") {
+		t.Errorf("previous match should wrap to line 29, got %q", out)
+	}
+	v.HandleSearch(tea.KeyMsg{Type: tea.KeyEsc})
+	if v.Searching() || v.query != "" {
+		t.Error("esc should end the search and clear the query")
+	}
+}
@@ -0,0 +1,99 @@
+// Package zone
+package zone
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"github.com/charmbracelet/lipgloss"
+)
+var marker = regexp.MustCompile(`\x1b\[(\d+)z`)
+var registry = struct {
+	sync.Mutex
+	ids   map[string]int
+	names []string
+}{ids: make(map[string]int)}
+type Zone struct {
+	ID     string
+	StartX int
+	StartY int
+	EndX   int
+	EndY   int
+}
+type Manager struct {
+	zones []Zone
+}
+func NewManager() *Manager {
+	return &Manager{}
+}
+func Mark(id, content string) string {
+	registry.Lock()
+	n, ok := registry.ids[id]
+	if !ok {
+		n = len(registry.names)
+		registry.ids[id] = n
+		registry.names = append(registry.names, id)
+	}
+	registry.Unlock()
+	tag := "\x1b[" + strconv.Itoa(n+1000) + "z"
+	return tag + content + tag
+}
+func name(n int) string {
+	registry.Lock()
+	defer registry.Unlock()
+	if n -= 1000; n >= 0 && n < len(registry.names) {
+		return registry.names[n]
+	}
+	return ""
+}
+func (m *Manager) Scan(view string) string {
+	m.zones = m.zones[:0]
+	open := make(map[string]Zone)
+	lines := strings.Split(view, "\n")
+	for y, line := range lines {
+		matches := marker.FindAllStringSubmatchIndex(line, -1)
+		if len(matches) == 0 {
+			continue
+		}
+		var stripped strings.Builder
+		last := 0
+		for _, match := range matches {
+			stripped.WriteString(line[last:match[0]])
+			last = match[1]
+			n, _ := strconv.Atoi(line[match[2]:match[3]])
+			id := name(n)
+			x := lipgloss.Width(stripped.String())
+			if zone, ok := open[id]; ok {
+				zone.EndX, zone.EndY = x, y
+				m.zones = append(m.zones, zone)
+				delete(open, id)
+				continue
+			}
+			open[id] = Zone{ID: id, StartX: x, StartY: y}
+		}
+		stripped.WriteString(line[last:])
+		lines[y] = stripped.String()
+	}
+	return strings.Join(lines, "\n")
+}
+func (m *Manager) At(x, y int) (Zone, bool) {
+	for i := len(m.zones) - 1; i >= 0; i-- {
+		zone := m.zones[i]
+		if y < zone.StartY || y > zone.EndY {
+			continue
+		}
+		if zone.StartY == zone.EndY && (x < zone.StartX || x >= zone.EndX) {
+			continue
+		}
+		return zone, true
+	}
+	return Zone{}, false
+}
+func (m *Manager) Get(id string) (Zone, bool) {
+	for _, zone := range m.zones {
+		if zone.ID == id {
+			return zone, true
+		}
+	}
+	return Zone{}, false
+}
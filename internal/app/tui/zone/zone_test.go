@@ -0,0 +1,36 @@
+package zone
+import "testing"
+func TestScan(t *testing.T) {
+	m := NewManager()
+	view := "title\n  " + Mark("save", "[save]") + " " + Mark("quit", "[quit]") + "\n" + Mark("list", "one\ntwo") + "\nend"
+	clean := m.Scan(view)
+	if clean != "title\n  [save] [quit]\none\ntwo\nend" {
+		t.Fatalf("Scan() = %q, want the markers stripped", clean)
+	}
+	tests := []struct {
+		x, y int
+		want string
+		ok   bool
+	}{
+		{x: 2, y: 1, want: "save", ok: true},
+		{x: 8, y: 1, ok: false},
+		{x: 9, y: 1, want: "quit", ok: true},
+		{x: 0, y: 2, want: "list", ok: true},
+		{x: 40, y: 3, want: "list", ok: true},
+		{x: 0, y: 0, ok: false},
+		{x: 0, y: 4, ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := m.At(tt.x, tt.y)
+		if ok != tt.ok || (ok && got.ID != tt.want) {
+			t.Errorf("At(%d, %d) = (%q, %v), want (%q, %v)", tt.x, tt.y, got.ID, ok, tt.want, tt.ok)
+		}
+	}
+	if z, ok := m.Get("quit"); !ok || z.StartX != 9 || z.EndX != 15 {
+		t.Errorf("Get(quit) = (%+v, %v), want columns 9 to 15", z, ok)
+	}
+	m.Scan("plain")
+	if _, ok := m.Get("save"); ok {
+		t.Error("Scan() should forget the zones of the previous view")
+	}
+}
@@ -3,13 +3,18 @@ package golang
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"time"
 	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
 )
 type Blueprint struct {
 	executor *executor.CommandExecutor
+	goBin    string
+	version  string
 }
 func NewBlueprint() *Blueprint {
 	return &Blueprint{
@@ -20,19 +25,66 @@ func (b *Blueprint) WithWorkingDir(dir string) *Blueprint {
 	b.executor = b.executor.WithWorkingDir(dir)
 	return b
 }
+func (b *Blueprint) WithTimeout(timeout time.Duration) *Blueprint {
+	b.executor = b.executor.WithTimeout(timeout)
+	return b
+}
+func (b *Blueprint) WithGoBin(dir string) *Blueprint {
+	b.goBin = dir
+	return b
+}
+func (b *Blueprint) WithVersion(version string) *Blueprint {
+	b.version = version
+	return b
+}
+func (b *Blueprint) TargetVersion() string {
+	if b.version == "" {
+		return "latest"
+	}
+	return b.version
+}
+func (b *Blueprint) InstallTarget() string {
+	return "github.com/melkeydev/go-blueprint@" + b.TargetVersion()
+}
+func (b *Blueprint) WorkingDir() string {
+	if b.executor == nil {
+		return ""
+	}
+	return b.executor.WorkingDir
+}
+func (b *Blueprint) binary() string {
+	if b.goBin != "" {
+		path := filepath.Join(b.goBin, "go-blueprint")
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return "go-blueprint"
+}
+func (b *Blueprint) installCommand(ctx context.Context) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "go", "install", b.InstallTarget())
+	cmd.WaitDelay = executor.WaitDelay
+	if b.goBin != "" {
+		cmd.Env = append(os.Environ(), "GOBIN="+b.goBin)
+	}
+	return cmd
+}
 func (b *Blueprint) ExecuteCommand(ctx context.Context, args ...string) *executor.CommandResult {
-	return b.executor.Execute(ctx, "go-blueprint", args...)
+	return b.executor.Execute(ctx, b.binary(), args...)
 }
 func (b *Blueprint) InstallCLI(ctx context.Context) error {
-	cmd := exec.CommandContext(ctx, "go", "install", "github.com/melkeydev/go-blueprint@latest")
+	cmd := b.installCommand(ctx)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
+func (b *Blueprint) Command(ctx context.Context, args ...string) *exec.Cmd {
+	return b.executor.Command(ctx, b.binary(), args...)
+}
 func (b *Blueprint) Create(ctx context.Context, projectName string, args ...string) error {
 	cmdArgs := []string{"create", "--name", projectName}
 	cmdArgs = append(cmdArgs, args...)
-	cmd := exec.CommandContext(ctx, "go-blueprint", cmdArgs...)
+	cmd := b.Command(ctx, cmdArgs...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = os.Stdin
@@ -42,7 +94,9 @@ func (b *Blueprint) CreateWithOutput(ctx context.Context, projectName string, ar
 	cmdArgs := []string{"create", "--name", projectName}
 	cmdArgs = append(cmdArgs, args...)
 	fullCmd := "go-blueprint " + strings.Join(cmdArgs, " ")
-	cmd := exec.CommandContext(ctx, "go-blueprint", cmdArgs...)
+	cmd := exec.CommandContext(ctx, b.binary(), cmdArgs...)
+	cmd.Dir = b.WorkingDir()
+	cmd.WaitDelay = executor.WaitDelay
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -95,7 +149,7 @@ func (b *Blueprint) CreateWithGitAndOutput(ctx context.Context, projectName, fra
 	return b.CreateWithOutput(ctx, projectName, args...)
 }
 func (b *Blueprint) InstallCLIWithOutput(ctx context.Context) (string, error) {
-	cmd := exec.CommandContext(ctx, "go", "install", "github.com/melkeydev/go-blueprint@latest")
+	cmd := b.installCommand(ctx)
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -128,11 +182,18 @@ func (b *Blueprint) CreateProject(ctx context.Context, projectName, framework, d
 	return b.Create(ctx, projectName, args...)
 }
 func (b *Blueprint) IsInstalled() bool {
-	_, err := exec.LookPath("go-blueprint")
+	_, err := exec.LookPath(b.binary())
 	return err == nil
 }
+func (b *Blueprint) Version(ctx context.Context) (string, error) {
+	result := b.ExecuteCommand(ctx, "version")
+	if result.Failed() {
+		return "", fmt.Errorf("go-blueprint version: %w", result.Error)
+	}
+	return strings.TrimSpace(result.Output()), nil
+}
 func (b *Blueprint) RunCommand(ctx context.Context, args ...string) error {
-	cmd := exec.CommandContext(ctx, "go-blueprint", args...)
+	cmd := b.Command(ctx, args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = os.Stdin
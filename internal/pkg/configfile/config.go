@@ -0,0 +1,125 @@
+package configfile
+import (
+	"fmt"
+	"strings"
+	"github.com/danielscoffee/dev-tools/internal/pkg/watch"
+	"github.com/spf13/viper"
+)
+const SchemaVersion = 2
+type Config struct {
+	Version      int                `mapstructure:"version" json:"version" yaml:"version"`
+	Profile      string             `mapstructure:"profile" json:"profile,omitempty" yaml:"profile,omitempty"`
+	Theme        string             `mapstructure:"theme" json:"theme" yaml:"theme"`
+	Paths        Paths              `mapstructure:"paths" json:"paths" yaml:"paths"`
+	Plugins      Plugins            `mapstructure:"plugins" json:"plugins" yaml:"plugins"`
+	Presets      map[string]Preset  `mapstructure:"presets" json:"presets,omitempty" yaml:"presets,omitempty"`
+	Tools        map[string]string  `mapstructure:"tools" json:"tools,omitempty" yaml:"tools,omitempty"`
+	Env          map[string]string  `mapstructure:"env" json:"env,omitempty" yaml:"env,omitempty"`
+	ModulePrefix string             `mapstructure:"module_prefix" json:"module_prefix,omitempty" yaml:"module_prefix,omitempty"`
+	Profiles     map[string]Profile `mapstructure:"profiles" json:"profiles,omitempty" yaml:"profiles,omitempty"`
+	KeymapPreset string             `mapstructure:"keymap_preset" json:"keymap_preset,omitempty" yaml:"keymap_preset,omitempty"`
+	Keymap       map[string]string  `mapstructure:"keymap" json:"keymap,omitempty" yaml:"keymap,omitempty"`
+	Watch        watch.Config       `mapstructure:"watch" json:"watch" yaml:"watch"`
+}
+func Default() Config {
+	return Config{
+		Version: SchemaVersion,
+		Theme:   "dark",
+		Presets:  map[string]Preset{},
+		Tools:    map[string]string{},
+		Env:      map[string]string{},
+		Profiles: map[string]Profile{},
+		Keymap:   map[string]string{},
+	}
+}
+var current *Config
+func Load(v *viper.Viper) (*Config, error) {
+	cfg := Default()
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+	cfg.Version = SchemaVersion
+	cfg.Theme = strings.ToLower(cfg.Theme)
+	cfg.Paths.Projects = expandHome(cfg.Paths.Projects)
+	cfg.Paths.GoBin = expandHome(cfg.Paths.GoBin)
+	return &cfg, nil
+}
+func Current() *Config {
+	if current == nil {
+		cfg, err := Load(viper.GetViper())
+		if err != nil {
+			defaults := Default()
+			cfg = &defaults
+			if active.loadErr == nil {
+				active.loadErr = fmt.Errorf("failed to decode config: %w", err)
+			}
+		}
+		current = cfg
+	}
+	return current
+}
+func Reload() error {
+	cfg, err := Load(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+	current = cfg
+	return nil
+}
+type migration func(settings map[string]any)
+var migrations = []migration{
+	migrateV0,
+	migrateV1,
+}
+func migrateV0(settings map[string]any) {
+	if keymap, ok := settings["keymap"].(map[string]any); ok {
+		if key, ok := keymap["navigate_languages"]; ok {
+			if _, exists := keymap["navigate_langs"]; !exists {
+				keymap["navigate_langs"] = key
+			}
+			delete(keymap, "navigate_languages")
+		}
+	}
+	if theme, ok := settings["theme"].(string); ok {
+		settings["theme"] = strings.ToLower(theme)
+	}
+}
+func migrateV1(settings map[string]any) {
+	keymap, ok := settings["keymap"].(map[string]any)
+	if !ok {
+		return
+	}
+	for old, action := range map[string]string{
+		"navigate_golang":    "navigate_langs_golang",
+		"navigate_blueprint": "navigate_langs_golang_blueprint",
+		"navigate_watch":     "navigate_langs_golang_watch",
+		"navigate_theme":     "navigate_config_theme",
+		"navigate_keys":      "navigate_config_keys",
+		"navigate_paths":     "navigate_config_paths",
+		"navigate_reset":     "navigate_config_reset",
+	} {
+		if key, ok := keymap[old]; ok {
+			if _, exists := keymap[action]; !exists {
+				keymap[action] = key
+			}
+			delete(keymap, old)
+		}
+	}
+}
+func Migrate(settings map[string]any) (int, bool) {
+	from := 0
+	switch version := settings["version"].(type) {
+	case int:
+		from = version
+	case float64:
+		from = int(version)
+	}
+	if from >= SchemaVersion {
+		return from, false
+	}
+	for version := from; version < SchemaVersion && version < len(migrations); version++ {
+		migrations[version](settings)
+	}
+	settings["version"] = SchemaVersion
+	return from, true
+}
@@ -0,0 +1,75 @@
+package configfile
+import (
+	"reflect"
+	"testing"
+)
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]any
+		want     map[string]any
+		from     int
+		changed  bool
+	}{
+		{
+			name:     "unversioned file is upgraded",
+			settings: map[string]any{"theme": "Dark"},
+			want:     map[string]any{"theme": "dark", "version": SchemaVersion},
+			from:     0,
+			changed:  true,
+		},
+		{
+			name:     "navigate_languages is renamed",
+			settings: map[string]any{"version": 0, "keymap": map[string]any{"navigate_languages": "L"}},
+			want:     map[string]any{"version": SchemaVersion, "keymap": map[string]any{"navigate_langs": "L"}},
+			from:     0,
+			changed:  true,
+		},
+		{
+			name:     "existing navigate_langs wins",
+			settings: map[string]any{"keymap": map[string]any{"navigate_languages": "L", "navigate_langs": "g"}},
+			want:     map[string]any{"version": SchemaVersion, "keymap": map[string]any{"navigate_langs": "g"}},
+			from:     0,
+			changed:  true,
+		},
+		{
+			name:     "short navigation actions get their full path",
+			settings: map[string]any{"version": 1, "keymap": map[string]any{"navigate_golang": "G", "navigate_theme": "T", "navigate_langs": "L", "navigate_config_keys": "K", "navigate_keys": "k"}},
+			want:     map[string]any{"version": SchemaVersion, "keymap": map[string]any{"navigate_langs_golang": "G", "navigate_config_theme": "T", "navigate_langs": "L", "navigate_config_keys": "K"}},
+			from:     1,
+			changed:  true,
+		},
+		{
+			name:     "current version is left alone",
+			settings: map[string]any{"version": SchemaVersion, "theme": "Dark"},
+			want:     map[string]any{"version": SchemaVersion, "theme": "Dark"},
+			from:     SchemaVersion,
+			changed:  false,
+		},
+		{
+			name:     "version decoded as float",
+			settings: map[string]any{"version": float64(SchemaVersion)},
+			want:     map[string]any{"version": float64(SchemaVersion)},
+			from:     SchemaVersion,
+			changed:  false,
+		},
+		{
+			name:     "newer version is left alone",
+			settings: map[string]any{"version": SchemaVersion + 1},
+			want:     map[string]any{"version": SchemaVersion + 1},
+			from:     SchemaVersion + 1,
+			changed:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, changed := Migrate(tt.settings)
+			if from != tt.from || changed != tt.changed {
+				t.Errorf("Migrate() = (%d, %v), want (%d, %v)", from, changed, tt.from, tt.changed)
+			}
+			if !reflect.DeepEqual(tt.settings, tt.want) {
+				t.Errorf("settings = %v, want %v", tt.settings, tt.want)
+			}
+		})
+	}
+}
@@ -1 +1,14 @@
 package configfile
+type Paths struct {
+	Projects string `mapstructure:"projects" json:"projects,omitempty" yaml:"projects,omitempty"`
+	GoBin    string `mapstructure:"gobin" json:"gobin,omitempty" yaml:"gobin,omitempty"`
+}
+type Plugins struct {
+	Path []string `mapstructure:"path" json:"path,omitempty" yaml:"path,omitempty"`
+}
+type Preset struct {
+	Framework string   `mapstructure:"framework" json:"framework,omitempty" yaml:"framework,omitempty"`
+	Driver    string   `mapstructure:"driver" json:"driver,omitempty" yaml:"driver,omitempty"`
+	Features  []string `mapstructure:"features" json:"features,omitempty" yaml:"features,omitempty"`
+	Git       string   `mapstructure:"git" json:"git,omitempty" yaml:"git,omitempty"`
+}
@@ -1,24 +1,84 @@
 package configfile
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
-	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
+	"path/filepath"
+	"github.com/danielscoffee/dev-tools/internal/pkg/xdg"
+)
+const (
+	FileName     = ".dev-tools.yaml"
+	UserFileName = "config.yaml"
 )
 type ConfigFile struct{}
-func (c *ConfigFile) InitConfig() {
+func DefaultPath() (string, error) {
+	dir, err := xdg.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, UserFileName), nil
+}
+func LegacyPath() (string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		cobra.CheckErr(err)
-		fmt.Printf("\n Error getting user home directory: %v", err)
-		os.Exit(1)
-	}
-	if _, err := os.Stat(home + "/.dev-tools.yaml"); os.IsNotExist(err) {
-		os.Create(home + "/.dev-tools.yaml")
-	}
-	cobra.CheckErr(err)
-	viper.AddConfigPath(home)
-	viper.SetConfigType("yaml")
-	viper.SetConfigName(".dev-tools")
-	viper.ReadInConfig()
+		return "", err
+	}
+	return filepath.Join(home, FileName), nil
+}
+func (c *ConfigFile) InitConfig() {
+	path, err := DefaultPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Could not resolve the config directory, using defaults: %v\n", err)
+		loadLayers("")
+		return
+	}
+	if moved, err := migrateLegacy(path); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Could not move the legacy config to %s: %v\n", path, err)
+	} else if moved != "" {
+		fmt.Fprintf(os.Stderr, "📦 Moved %s to %s\n", moved, path)
+	}
+	loadLayers(path)
+}
+func migrateLegacy(path string) (string, error) {
+	legacy, err := LegacyPath()
+	if err != nil {
+		return "", nil
+	}
+	info, err := os.Stat(legacy)
+	if err != nil || info.IsDir() {
+		return "", nil
+	}
+	if _, err := os.Stat(path); err == nil {
+		return "", nil
+	}
+	if info.Size() == 0 {
+		return "", os.Remove(legacy)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.Rename(legacy, path); err == nil {
+		return legacy, nil
+	}
+	if err := copyFile(legacy, path); err != nil {
+		return "", err
+	}
+	return legacy, os.Remove(legacy)
+}
+func copyFile(from, to string) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return errors.Join(err, os.Remove(to))
+	}
+	return out.Close()
 }
@@ -0,0 +1,46 @@
+package configfile
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+func TestMigrateLegacy(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	legacy := filepath.Join(home, FileName)
+	path := filepath.Join(home, ".config", "dev-tools", UserFileName)
+	if moved, err := migrateLegacy(path); err != nil || moved != "" {
+		t.Fatalf("migrateLegacy() without a legacy file = (%q, %v)", moved, err)
+	}
+	if err := os.WriteFile(legacy, []byte("theme: light\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	moved, err := migrateLegacy(path)
+	if err != nil || moved != legacy {
+		t.Fatalf("migrateLegacy() = (%q, %v), want (%q, nil)", moved, err, legacy)
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != "theme: light\n" {
+		t.Errorf("new config = %q, %v", data, err)
+	}
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Error("the legacy file should be gone after the move")
+	}
+	os.WriteFile(legacy, []byte("theme: dark\n"), 0o644)
+	if moved, err := migrateLegacy(path); err != nil || moved != "" {
+		t.Errorf("migrateLegacy() over an existing config = (%q, %v)", moved, err)
+	}
+	if _, err := os.Stat(legacy); err != nil {
+		t.Error("the legacy file should be left alone when the new config exists")
+	}
+	os.Remove(path)
+	os.WriteFile(legacy, nil, 0o644)
+	if moved, err := migrateLegacy(path); err != nil || moved != "" {
+		t.Errorf("migrateLegacy() with an empty legacy file = (%q, %v)", moved, err)
+	}
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Error("an empty legacy file should be removed")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("an empty legacy file should not create a config")
+	}
+}
@@ -0,0 +1,252 @@
+package configfile
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"github.com/go-viper/mapstructure/v2"
+)
+type Validator func(value any) []Issue
+var validators = map[string]Validator{}
+func RegisterValidator(key string, validate Validator) {
+	validators[key] = validate
+}
+type KeySpec struct {
+	Key         string
+	Type        string
+	Description string
+	Allowed     []string
+}
+var KnownKeys = []KeySpec{
+	{Key: "version", Type: "int", Description: "Config schema version, upgraded automatically"},
+	{Key: "profile", Type: "string", Description: "Profile applied on top of the config files, overridden by --profile and DEV_TOOLS_PROFILE"},
+	{Key: "theme", Type: "string", Description: "TUI theme", Allowed: []string{"themeless", "dark", "light"}},
+	{Key: "paths.projects", Type: "string", Description: "Directory new projects are created in"},
+	{Key: "paths.gobin", Type: "string", Description: "GOBIN used when installing tools such as go-blueprint"},
+	{Key: "plugins.path", Type: "list", Description: "dev-tools-* plugins on PATH the TUI may run, by name; plugins in the plugins directory always run"},
+	{Key: "presets", Type: "map", Description: "Named blueprint presets with framework, driver, features and git"},
+	{Key: "tools", Type: "map", Description: "Tool versions installed by dev-tools, e.g. go-blueprint: v0.10.3"},
+	{Key: "env", Type: "map", Description: "Environment variables set for every command, e.g. GOPRIVATE"},
+	{Key: "module_prefix", Type: "string", Description: "Module path prefix for new projects, e.g. github.com/acme"},
+	{Key: "profiles", Type: "map", Description: "Named profiles overriding theme, paths, presets, tools, env and module_prefix"},
+	{Key: "keymap_preset", Type: "string", Description: "TUI key preset the keymap is applied on top of", Allowed: []string{"default", "vim", "emacs"}},
+	{Key: "keymap", Type: "map", Description: "TUI shortcut overrides keyed by action, e.g. navigate_langs: L, quit: ctrl+x ctrl+c, up: k, ctrl+p"},
+	{Key: "tasks", Type: "map", Description: "Project tasks run with 'dev-tools run', keyed by task name"},
+	{Key: "watch", Type: "map", Description: "Defaults for 'dev-tools golang watch': package, bin, args, include, exclude, pre, post, env_file, debounce"},
+}
+type Issue struct {
+	Key     string `json:"key" yaml:"key"`
+	Message string `json:"message" yaml:"message"`
+	File    string `json:"file,omitempty" yaml:"file,omitempty"`
+	Line    int    `json:"line,omitempty" yaml:"line,omitempty"`
+}
+func (i Issue) Error() string {
+	if i.Line > 0 {
+		return fmt.Sprintf("line %d: %s: %s", i.Line, i.Key, i.Message)
+	}
+	return fmt.Sprintf("%s: %s", i.Key, i.Message)
+}
+func LookupKey(key string) (KeySpec, bool) {
+	key = strings.ToLower(key)
+	for _, spec := range KnownKeys {
+		if key == spec.Key || strings.HasPrefix(key, spec.Key+".") {
+			return spec, true
+		}
+	}
+	return KeySpec{}, false
+}
+func Validate(s *Store) []Issue {
+	var issues []Issue
+	settings := s.Settings()
+	for _, root := range sortedKeys(settings) {
+		known := false
+		for _, spec := range KnownKeys {
+			if strings.SplitN(spec.Key, ".", 2)[0] == root {
+				known = true
+				break
+			}
+		}
+		if !known {
+			issues = append(issues, Issue{Key: root, Message: "unknown key"})
+		}
+	}
+	for _, section := range []string{"paths", "plugins"} {
+		if values, ok := settings[section].(map[string]any); ok {
+			for _, key := range sortedKeys(values) {
+				if _, ok := LookupKey(section + "." + key); !ok {
+					issues = append(issues, Issue{Key: section + "." + key, Message: "unknown key"})
+				}
+			}
+		}
+	}
+	for _, spec := range KnownKeys {
+		value, ok := s.Get(spec.Key)
+		if !ok {
+			continue
+		}
+		issues = append(issues, validateValue(spec, value)...)
+	}
+	for i := range issues {
+		issues[i].File = s.Path()
+		issues[i].Line = s.Line(issues[i].Key)
+	}
+	return issues
+}
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+func validateValue(spec KeySpec, value any) []Issue {
+	switch spec.Type {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return []Issue{{Key: spec.Key, Message: fmt.Sprintf("expected a string, got %T", value)}}
+		}
+		if len(spec.Allowed) > 0 && !contains(spec.Allowed, strings.ToLower(str)) {
+			return []Issue{{Key: spec.Key, Message: fmt.Sprintf("%q is not one of %s", str, strings.Join(spec.Allowed, ", "))}}
+		}
+	case "int":
+		version, ok := value.(int)
+		if !ok {
+			return []Issue{{Key: spec.Key, Message: fmt.Sprintf("expected an integer, got %T", value)}}
+		}
+		if spec.Key == "version" && version > SchemaVersion {
+			return []Issue{{Key: spec.Key, Message: fmt.Sprintf("version %d is newer than the supported version %d, upgrade dev-tools", version, SchemaVersion)}}
+		}
+	case "map":
+		if _, ok := value.(map[string]any); !ok {
+			return []Issue{{Key: spec.Key, Message: fmt.Sprintf("expected a map, got %T", value)}}
+		}
+	case "list":
+		switch items := value.(type) {
+		case []string:
+		case []any:
+			for _, item := range items {
+				if _, ok := item.(string); !ok {
+					return []Issue{{Key: spec.Key, Message: fmt.Sprintf("expected a list of strings, got %T", item)}}
+				}
+			}
+		default:
+			return []Issue{{Key: spec.Key, Message: fmt.Sprintf("expected a list, got %T", value)}}
+		}
+	}
+	switch spec.Key {
+	case "presets":
+		return validatePresets(value)
+	case "keymap":
+		return validateKeymap(value)
+	case "tools", "env":
+		return validateStrings(spec.Key, value)
+	case "profiles":
+		return validateProfiles(value)
+	}
+	if validate, ok := validators[spec.Key]; ok {
+		return validate(value)
+	}
+	return nil
+}
+func validatePresets(value any) []Issue {
+	var issues []Issue
+	presets, _ := value.(map[string]any)
+	for name, raw := range presets {
+		var preset Preset
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			Result:      &preset,
+			ErrorUnused: true,
+		})
+		if err == nil {
+			err = decoder.Decode(raw)
+		}
+		if err != nil {
+			issues = append(issues, Issue{Key: "presets." + name, Message: DecodeMessage(err)})
+		}
+	}
+	return issues
+}
+func validateStrings(key string, value any) []Issue {
+	var issues []Issue
+	values, _ := value.(map[string]any)
+	for _, name := range sortedKeys(values) {
+		if _, ok := values[name].(string); !ok {
+			issues = append(issues, Issue{Key: key + "." + name, Message: fmt.Sprintf("expected a string, got %T", values[name])})
+		}
+	}
+	return issues
+}
+func validateProfiles(value any) []Issue {
+	var issues []Issue
+	profiles, _ := value.(map[string]any)
+	for _, name := range sortedKeys(profiles) {
+		var profile Profile
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			Result:      &profile,
+			ErrorUnused: true,
+		})
+		if err == nil {
+			err = decoder.Decode(profiles[name])
+		}
+		if err != nil {
+			issues = append(issues, Issue{Key: "profiles." + name, Message: DecodeMessage(err)})
+			continue
+		}
+		if profile.Theme != "" {
+			spec, _ := LookupKey("theme")
+			for _, issue := range validateValue(spec, strings.ToLower(profile.Theme)) {
+				issues = append(issues, Issue{Key: "profiles." + name + "." + issue.Key, Message: issue.Message})
+			}
+		}
+	}
+	return issues
+}
+func DecodeMessage(err error) string {
+	var messages []string
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if line == "" || strings.HasPrefix(line, "decoding failed") {
+			continue
+		}
+		messages = append(messages, strings.TrimPrefix(line, "'' "))
+	}
+	if len(messages) == 0 {
+		return err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+func validateKeymap(value any) []Issue {
+	var issues []Issue
+	keymap, _ := value.(map[string]any)
+	var actions []string
+	for action := range keymap {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	for _, action := range actions {
+		key, ok := keymap[action].(string)
+		if !ok || strings.Trim(key, ", ") == "" {
+			issues = append(issues, Issue{Key: "keymap." + action, Message: "expected a non-empty key"})
+		}
+	}
+	return issues
+}
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
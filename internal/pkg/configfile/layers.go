@@ -0,0 +1,172 @@
+package configfile
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+const EnvPrefix = "DEV_TOOLS"
+type Origin string
+const (
+	OriginDefault Origin = "default"
+	OriginUser    Origin = "user"
+	OriginProject Origin = "project"
+	OriginProfile Origin = "profile"
+	OriginEnv     Origin = "env"
+	OriginFlag    Origin = "flag"
+)
+type Source struct {
+	Origin Origin `json:"origin" yaml:"origin"`
+	Path   string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+func (s Source) String() string {
+	if s.Path == "" {
+		return string(s.Origin)
+	}
+	return string(s.Origin) + ":" + s.Path
+}
+var Defaults = map[string]any{
+	"theme": "dark",
+}
+type layers struct {
+	userPath        string
+	projectDir      string
+	user            *Store
+	project         *Store
+	flags           map[string]*pflag.Flag
+	override        string
+	overridden      bool
+	profile         string
+	profileSettings map[string]any
+	profileErr      error
+	loadErr         error
+}
+var active = &layers{flags: make(map[string]*pflag.Flag)}
+func EnvName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+}
+func BindFlag(key string, flag *pflag.Flag) {
+	if flag == nil {
+		return
+	}
+	active.flags[key] = flag
+	viper.BindPFlag(key, flag)
+}
+func bindEnv() {
+	for _, spec := range KnownKeys {
+		if spec.Type != "map" {
+			viper.BindEnv(spec.Key, EnvName(spec.Key))
+		}
+	}
+}
+func loadLayers(userPath string) error {
+	active.userPath = userPath
+	active.user, active.project = nil, nil
+	var errs []error
+	if userPath != "" {
+		if store, err := OpenStore(userPath); err == nil {
+			active.user = store
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	project, err := LocalPath()
+	if active.projectDir != "" {
+		project, err = ProjectPath(active.projectDir), nil
+	}
+	if err == nil && project != userPath {
+		if store, err := OpenStore(project); err == nil {
+			active.project = store
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	active.loadErr = errors.Join(append(errs, active.apply())...)
+	return active.loadErr
+}
+func LoadError() error {
+	return active.loadErr
+}
+func Refresh() error {
+	return loadLayers(active.userPath)
+}
+func OpenProject(dir string) error {
+	active.projectDir = dir
+	return loadLayers(active.userPath)
+}
+func (l *layers) apply() error {
+	viper.Reset()
+	for key, value := range Defaults {
+		viper.SetDefault(key, value)
+	}
+	if l.user != nil {
+		viper.MergeConfigMap(l.user.Settings())
+	}
+	if l.project != nil {
+		viper.MergeConfigMap(l.project.Settings())
+	}
+	bindEnv()
+	for key, flag := range l.flags {
+		viper.BindPFlag(key, flag)
+	}
+	l.applyProfile()
+	return Reload()
+}
+func (l *layers) applyProfile() {
+	name := strings.ToLower(viper.GetString("profile"))
+	if l.overridden {
+		name = l.override
+		viper.Set("profile", name)
+	}
+	l.profile, l.profileSettings, l.profileErr = name, nil, nil
+	if name == "" {
+		return
+	}
+	settings, ok := viper.Get("profiles." + name).(map[string]any)
+	if !ok {
+		l.profileErr = fmt.Errorf("profile %q is not defined", name)
+		return
+	}
+	l.profileSettings = settings
+	viper.MergeConfigMap(settings)
+}
+func OriginOf(key string) Source {
+	key = strings.ToLower(key)
+	if flag, ok := active.flags[key]; ok && flag.Changed {
+		return Source{Origin: OriginFlag, Path: "--" + flag.Name}
+	}
+	if spec, ok := LookupKey(key); ok && spec.Key == key && spec.Type != "map" {
+		if _, ok := os.LookupEnv(EnvName(key)); ok {
+			return Source{Origin: OriginEnv, Path: EnvName(key)}
+		}
+	}
+	if active.profileSettings != nil {
+		if _, ok := lookup(active.profileSettings, key); ok {
+			return Source{Origin: OriginProfile, Path: active.profile}
+		}
+	}
+	if active.project != nil {
+		if _, ok := active.project.Get(key); ok {
+			return Source{Origin: OriginProject, Path: active.project.Path()}
+		}
+	}
+	if active.user != nil {
+		if _, ok := active.user.Get(key); ok {
+			return Source{Origin: OriginUser, Path: active.user.Path()}
+		}
+	}
+	return Source{Origin: OriginDefault}
+}
+func Keys() []string {
+	var keys []string
+	for _, key := range viper.AllKeys() {
+		if viper.Get(key) != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
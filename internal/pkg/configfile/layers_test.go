@@ -0,0 +1,162 @@
+package configfile
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+func TestLayers(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    string
+		project string
+		env     map[string]string
+		flag    string
+		key     string
+		want    string
+		origin  Origin
+	}{
+		{name: "defaults", key: "theme", want: "dark", origin: OriginDefault},
+		{name: "user file", user: "theme: light\n", key: "theme", want: "light", origin: OriginUser},
+		{name: "project file overrides user file", user: "theme: light\n", project: "theme: themeless\n", key: "theme", want: "themeless", origin: OriginProject},
+		{name: "user keys the project file does not set survive", user: "paths:\n  gobin: /user/bin\n", project: "theme: light\n", key: "paths.gobin", want: "/user/bin", origin: OriginUser},
+		{name: "nested keys merge across files", user: "paths:\n  projects: /src\n  gobin: /user/bin\n", project: "paths:\n  gobin: /project/bin\n", key: "paths.projects", want: "/src", origin: OriginUser},
+		{name: "env overrides files", project: "theme: themeless\n", env: map[string]string{"DEV_TOOLS_THEME": "light"}, key: "theme", want: "light", origin: OriginEnv},
+		{name: "nested env name", user: "paths:\n  gobin: /user/bin\n", env: map[string]string{"DEV_TOOLS_PATHS_GOBIN": "/env/bin"}, key: "paths.gobin", want: "/env/bin", origin: OriginEnv},
+		{name: "profile overrides files", user: "profile: work\nmodule_prefix: github.com/acme\nprofiles:\n  work:\n    module_prefix: github.com/work\n", key: "module_prefix", want: "github.com/work", origin: OriginProfile},
+		{name: "project file selects a user profile", user: "profiles:\n  work:\n    theme: light\n", project: "profile: work\n", key: "theme", want: "light", origin: OriginProfile},
+		{name: "env overrides profiles", user: "profile: work\nprofiles:\n  work:\n    theme: themeless\n", env: map[string]string{"DEV_TOOLS_THEME": "light"}, key: "theme", want: "light", origin: OriginEnv},
+		{name: "env selects the profile", user: "profiles:\n  work:\n    theme: light\n", env: map[string]string{"DEV_TOOLS_PROFILE": "work"}, key: "theme", want: "light", origin: OriginProfile},
+		{name: "flag overrides env", env: map[string]string{"DEV_TOOLS_THEME": "light"}, flag: "themeless", key: "theme", want: "themeless", origin: OriginFlag},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home, project := t.TempDir(), t.TempDir()
+			t.Setenv("HOME", home)
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			userPath := filepath.Join(home, UserFileName)
+			for path, content := range map[string]string{userPath: tt.user, filepath.Join(project, FileName): tt.project} {
+				if content != "" {
+					if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			t.Chdir(project)
+			t.Cleanup(func() {
+				active = &layers{flags: make(map[string]*pflag.Flag)}
+				viper.Reset()
+			})
+			if tt.flag != "" {
+				flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+				flags.String("theme", "", "")
+				flags.Set("theme", tt.flag)
+				BindFlag("theme", flags.Lookup("theme"))
+			}
+			loadLayers(userPath)
+			if got := viper.GetString(tt.key); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.key, got, tt.want)
+			}
+			if got := OriginOf(tt.key).Origin; got != tt.origin {
+				t.Errorf("OriginOf(%s) = %s, want %s", tt.key, got, tt.origin)
+			}
+		})
+	}
+}
+func TestEnvName(t *testing.T) {
+	for key, want := range map[string]string{
+		"theme":         "DEV_TOOLS_THEME",
+		"paths.gobin":   "DEV_TOOLS_PATHS_GOBIN",
+		"module-prefix": "DEV_TOOLS_MODULE_PREFIX",
+		"keymap_preset": "DEV_TOOLS_KEYMAP_PRESET",
+	} {
+		if got := EnvName(key); got != want {
+			t.Errorf("EnvName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+func TestRefresh(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(t.TempDir())
+	t.Cleanup(func() {
+		active = &layers{flags: make(map[string]*pflag.Flag)}
+		viper.Reset()
+	})
+	path := filepath.Join(home, UserFileName)
+	os.WriteFile(path, []byte("theme: light\n"), 0o644)
+	if err := loadLayers(path); err != nil {
+		t.Fatalf("loadLayers() error = %v", err)
+	}
+	os.WriteFile(path, []byte("theme: themeless\n"), 0o644)
+	if got := Current().Theme; got != "light" {
+		t.Fatalf("Theme before Refresh = %q, want light", got)
+	}
+	if err := Refresh(); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if got := Current().Theme; got != "themeless" {
+		t.Errorf("Theme after Refresh = %q, want themeless", got)
+	}
+}
+func TestOpenProject(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cwd := t.TempDir()
+	t.Chdir(cwd)
+	t.Cleanup(func() {
+		active = &layers{flags: make(map[string]*pflag.Flag)}
+		viper.Reset()
+	})
+	project := t.TempDir()
+	os.WriteFile(filepath.Join(project, FileName), []byte("theme: light\n"), 0o644)
+	os.MkdirAll(filepath.Join(project, "cmd"), 0o755)
+	if err := OpenProject(filepath.Join(project, "cmd")); err != nil {
+		t.Fatalf("OpenProject() error = %v", err)
+	}
+	if got := Current().Theme; got != "light" {
+		t.Errorf("Theme = %q, want the project's light", got)
+	}
+	if got, want := OriginOf("theme").Path, filepath.Join(project, FileName); got != want {
+		t.Errorf("OriginOf(theme).Path = %q, want %q", got, want)
+	}
+	if err := Refresh(); err != nil || Current().Theme != "light" {
+		t.Errorf("Refresh() should keep the opened project, theme %q, error %v", Current().Theme, err)
+	}
+	if wd, _ := os.Getwd(); wd != cwd {
+		t.Errorf("working directory = %q, want it unchanged %q", wd, cwd)
+	}
+}
+func TestLoadError(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Cleanup(func() {
+		active = &layers{flags: make(map[string]*pflag.Flag)}
+		viper.Reset()
+	})
+	for _, files := range [][2]string{
+		{"theme: light\n", "theme: dark\n"},
+		{"theme: [light\n", ""},
+		{"", "theme: [dark\n"},
+		{"theme:\n  name: dark\n", ""},
+	} {
+		dir := t.TempDir()
+		userPath := filepath.Join(dir, UserFileName)
+		if files[0] != "" {
+			os.WriteFile(userPath, []byte(files[0]), 0o644)
+		}
+		if files[1] != "" {
+			os.WriteFile(filepath.Join(dir, FileName), []byte(files[1]), 0o644)
+		}
+		t.Chdir(dir)
+		err := loadLayers(userPath)
+		valid := files[0] == "theme: light\n"
+		if (err == nil) != valid {
+			t.Errorf("loadLayers(%q) error = %v", files, err)
+		}
+		if LoadError() != err {
+			t.Errorf("LoadError() = %v, want %v", LoadError(), err)
+		}
+	}
+}
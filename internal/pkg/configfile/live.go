@@ -0,0 +1,33 @@
+package configfile
+import (
+	"errors"
+	"github.com/fsnotify/fsnotify"
+)
+func (s *Store) Watch(onChange func(path string)) {
+	path := s.path
+	s.v.OnConfigChange(func(fsnotify.Event) {
+		onChange(path)
+	})
+	s.v.WatchConfig()
+}
+func Watch(onChange func(path string)) {
+	for _, store := range []*Store{active.user, active.project} {
+		if store != nil {
+			store.Watch(onChange)
+		}
+	}
+}
+func ReloadFile(path string) error {
+	store, err := OpenStore(path)
+	if err != nil {
+		return err
+	}
+	if issues := Validate(store); len(issues) > 0 {
+		errs := make([]error, len(issues))
+		for i, issue := range issues {
+			errs[i] = issue
+		}
+		return errors.Join(errs...)
+	}
+	return Refresh()
+}
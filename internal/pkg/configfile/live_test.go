@@ -0,0 +1,34 @@
+package configfile
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+func TestReloadFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(t.TempDir())
+	t.Cleanup(func() {
+		active = &layers{flags: make(map[string]*pflag.Flag)}
+		viper.Reset()
+	})
+	path := filepath.Join(home, UserFileName)
+	os.WriteFile(path, []byte("version: 2\ntheme: light\n"), 0o644)
+	loadLayers(path)
+	os.WriteFile(path, []byte("version: 2\ntheme: neon\n"), 0o644)
+	if err := ReloadFile(path); err == nil {
+		t.Fatal("ReloadFile() should reject an invalid file")
+	}
+	if got := Current().Theme; got != "light" {
+		t.Errorf("Theme after a rejected reload = %q, want light", got)
+	}
+	os.WriteFile(path, []byte("version: 2\ntheme: dark\n"), 0o644)
+	if err := ReloadFile(path); err != nil {
+		t.Fatalf("ReloadFile() error = %v", err)
+	}
+	if got := Current().Theme; got != "dark" {
+		t.Errorf("Theme after a reload = %q, want dark", got)
+	}
+}
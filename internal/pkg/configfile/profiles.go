@@ -0,0 +1,83 @@
+package configfile
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+type Profile struct {
+	Theme        string            `mapstructure:"theme" json:"theme,omitempty" yaml:"theme,omitempty"`
+	Paths        Paths             `mapstructure:"paths" json:"paths,omitempty" yaml:"paths,omitempty"`
+	Presets      map[string]Preset `mapstructure:"presets" json:"presets,omitempty" yaml:"presets,omitempty"`
+	Tools        map[string]string `mapstructure:"tools" json:"tools,omitempty" yaml:"tools,omitempty"`
+	Env          map[string]string `mapstructure:"env" json:"env,omitempty" yaml:"env,omitempty"`
+	ModulePrefix string            `mapstructure:"module_prefix" json:"module_prefix,omitempty" yaml:"module_prefix,omitempty"`
+}
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+func (c *Config) ToolVersion(tool string) string {
+	if version := c.Tools[strings.ToLower(tool)]; version != "" {
+		return version
+	}
+	return "latest"
+}
+var appliedEnv = map[string]*string{}
+func (c *Config) ApplyEnv() error {
+	for key, original := range appliedEnv {
+		if original == nil {
+			os.Unsetenv(key)
+		} else {
+			os.Setenv(key, *original)
+		}
+	}
+	appliedEnv = map[string]*string{}
+	for key, value := range c.Env {
+		key = strings.ToUpper(key)
+		if original, ok := os.LookupEnv(key); ok {
+			appliedEnv[key] = &original
+		} else {
+			appliedEnv[key] = nil
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+	return nil
+}
+func (c *Config) ModulePath(name string) string {
+	if c.ModulePrefix == "" || strings.Contains(name, "/") {
+		return name
+	}
+	return strings.TrimSuffix(c.ModulePrefix, "/") + "/" + name
+}
+func ActiveProfile() (string, error) {
+	return active.profile, active.profileErr
+}
+func UseProfile(name string) error {
+	active.override = strings.ToLower(name)
+	active.overridden = true
+	if err := active.apply(); err != nil {
+		return err
+	}
+	return active.profileErr
+}
+func lookup(settings map[string]any, key string) (any, bool) {
+	parts := strings.Split(strings.ToLower(key), ".")
+	var value any = settings
+	for _, part := range parts {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,83 @@
+package configfile
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+func TestConfigProfileHelpers(t *testing.T) {
+	cfg := &Config{
+		ModulePrefix: "github.com/acme/",
+		Tools:        map[string]string{"golangci-lint": "v1.59.0"},
+		Profiles:     map[string]Profile{"work": {}, "home": {}},
+	}
+	for name, want := range map[string]string{
+		"api":                     "github.com/acme/api",
+		"github.com/other/module": "github.com/other/module",
+	} {
+		if got := cfg.ModulePath(name); got != want {
+			t.Errorf("ModulePath(%q) = %q, want %q", name, got, want)
+		}
+	}
+	if got := (&Config{}).ModulePath("api"); got != "api" {
+		t.Errorf("ModulePath without a prefix = %q, want api", got)
+	}
+	if got := cfg.ToolVersion("GolangCI-Lint"); got != "v1.59.0" {
+		t.Errorf("ToolVersion() = %q, want v1.59.0", got)
+	}
+	if got := cfg.ToolVersion("gofumpt"); got != "latest" {
+		t.Errorf("ToolVersion() = %q, want latest", got)
+	}
+	if got := cfg.ProfileNames(); len(got) != 2 || got[0] != "home" || got[1] != "work" {
+		t.Errorf("ProfileNames() = %v, want [home work]", got)
+	}
+}
+func TestApplyEnv(t *testing.T) {
+	t.Setenv("DEV_TOOLS_TEST_KEPT", "original")
+	os.Unsetenv("DEV_TOOLS_TEST_NEW")
+	if err := (&Config{Env: map[string]string{"dev_tools_test_kept": "work", "DEV_TOOLS_TEST_NEW": "1"}}).ApplyEnv(); err != nil {
+		t.Fatalf("ApplyEnv() error = %v", err)
+	}
+	if os.Getenv("DEV_TOOLS_TEST_KEPT") != "work" || os.Getenv("DEV_TOOLS_TEST_NEW") != "1" {
+		t.Fatalf("ApplyEnv() did not set the profile env")
+	}
+	if err := (&Config{}).ApplyEnv(); err != nil {
+		t.Fatalf("ApplyEnv() error = %v", err)
+	}
+	if got := os.Getenv("DEV_TOOLS_TEST_KEPT"); got != "original" {
+		t.Errorf("DEV_TOOLS_TEST_KEPT = %q, want it restored", got)
+	}
+	if _, ok := os.LookupEnv("DEV_TOOLS_TEST_NEW"); ok {
+		t.Error("DEV_TOOLS_TEST_NEW should be unset again")
+	}
+}
+func TestUseProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Chdir(t.TempDir())
+	t.Cleanup(func() {
+		active = &layers{flags: make(map[string]*pflag.Flag)}
+		viper.Reset()
+	})
+	path := filepath.Join(home, UserFileName)
+	if err := os.WriteFile(path, []byte("profile: work\nprofiles:\n  work:\n    theme: light\n  home:\n    theme: themeless\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	loadLayers(path)
+	if name, err := ActiveProfile(); name != "work" || err != nil {
+		t.Fatalf("ActiveProfile() = (%q, %v), want work", name, err)
+	}
+	if err := UseProfile("HOME"); err != nil {
+		t.Fatalf("UseProfile() error = %v", err)
+	}
+	if got := viper.GetString("theme"); got != "themeless" {
+		t.Errorf("theme = %q, want themeless", got)
+	}
+	if err := UseProfile("missing"); err == nil {
+		t.Error("UseProfile() should fail for an undefined profile")
+	}
+	if got := viper.GetString("theme"); got != "dark" {
+		t.Errorf("theme after an undefined profile = %q, want the default", got)
+	}
+}
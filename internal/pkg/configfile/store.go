@@ -0,0 +1,208 @@
+package configfile
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+type Scope string
+const (
+	ScopeGlobal Scope = "global"
+	ScopeLocal  Scope = "local"
+)
+func LocalPath() (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return ProjectPath(cwd), nil
+}
+func ProjectPath(dir string) string {
+	if path, ok := FindProject(dir); ok {
+		return path
+	}
+	return filepath.Join(dir, FileName)
+}
+func FindProject(dir string) (string, bool) {
+	legacy, _ := LegacyPath()
+	for {
+		path := filepath.Join(dir, FileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() && path != legacy {
+			return path, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+func PathFor(scope Scope) (string, error) {
+	if scope == ScopeLocal {
+		return LocalPath()
+	}
+	return DefaultPath()
+}
+type Store struct {
+	path         string
+	v            *viper.Viper
+	root         *yaml.Node
+	migratedFrom int
+	migrated     bool
+}
+func newViper(path string) *viper.Viper {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	return v
+}
+func OpenStore(path string) (*Store, error) {
+	s := &Store{path: path, v: newViper(path)}
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err := s.v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if data, err := os.ReadFile(path); err == nil {
+		var root yaml.Node
+		if yaml.Unmarshal(data, &root) == nil {
+			s.root = &root
+		}
+	}
+	settings := s.v.AllSettings()
+	if from, changed := Migrate(settings); changed && len(settings) > 1 {
+		s.migratedFrom, s.migrated = from, true
+		s.v = newViper(path)
+		s.v.MergeConfigMap(settings)
+	}
+	return s, nil
+}
+func (s *Store) Migrated() (int, bool) {
+	return s.migratedFrom, s.migrated
+}
+func (s *Store) Line(key string) int {
+	if s.root == nil || len(s.root.Content) == 0 {
+		return 0
+	}
+	node := s.root.Content[0]
+	line := 0
+	for _, part := range strings.Split(strings.ToLower(key), ".") {
+		if node.Kind != yaml.MappingNode {
+			break
+		}
+		found := false
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if strings.ToLower(node.Content[i].Value) == part {
+				line = node.Content[i].Line
+				node = node.Content[i+1]
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return line
+}
+func OpenScope(scope Scope) (*Store, error) {
+	path, err := PathFor(scope)
+	if err != nil {
+		return nil, err
+	}
+	return OpenStore(path)
+}
+func (s *Store) Path() string {
+	return s.path
+}
+func (s *Store) Get(key string) (any, bool) {
+	if !s.v.IsSet(key) {
+		return nil, false
+	}
+	return s.v.Get(key), true
+}
+func (s *Store) Set(key string, value any) {
+	s.v.Set(key, value)
+}
+func (s *Store) Unset(key string) bool {
+	settings := s.v.AllSettings()
+	if !deleteKey(settings, strings.Split(strings.ToLower(key), ".")) {
+		return false
+	}
+	s.v = newViper(s.path)
+	s.v.MergeConfigMap(settings)
+	return true
+}
+func deleteKey(m map[string]any, parts []string) bool {
+	if len(parts) == 1 {
+		if _, ok := m[parts[0]]; !ok {
+			return false
+		}
+		delete(m, parts[0])
+		return true
+	}
+	child, ok := m[parts[0]].(map[string]any)
+	if !ok || !deleteKey(child, parts[1:]) {
+		return false
+	}
+	if len(child) == 0 {
+		delete(m, parts[0])
+	}
+	return true
+}
+func (s *Store) Settings() map[string]any {
+	return s.v.AllSettings()
+}
+func (s *Store) Keys() []string {
+	keys := s.v.AllKeys()
+	sort.Strings(keys)
+	return keys
+}
+func (s *Store) Save() error {
+	if !s.v.IsSet("version") {
+		s.v.Set("version", SchemaVersion)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	return s.v.WriteConfigAs(s.path)
+}
+func ParseValue(raw, kind string) (any, error) {
+	switch kind {
+	case "", "auto":
+		if b, err := strconv.ParseBool(raw); err == nil && (raw == "true" || raw == "false") {
+			return b, nil
+		}
+		if i, err := strconv.Atoi(raw); err == nil {
+			return i, nil
+		}
+		if f, err := strconv.ParseFloat(raw, 64); err == nil {
+			return f, nil
+		}
+		return raw, nil
+	case "string":
+		return raw, nil
+	case "int":
+		return strconv.Atoi(raw)
+	case "float":
+		return strconv.ParseFloat(raw, 64)
+	case "bool":
+		return strconv.ParseBool(raw)
+	case "list":
+		if raw == "" {
+			return []string{}, nil
+		}
+		items := strings.Split(raw, ",")
+		for i := range items {
+			items[i] = strings.TrimSpace(items[i])
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("unknown value type %q (use auto, string, int, float, bool or list)", kind)
+}
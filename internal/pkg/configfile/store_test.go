@@ -0,0 +1,186 @@
+package configfile
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		raw     string
+		kind    string
+		want    any
+		wantErr bool
+	}{
+		{raw: "true", want: true},
+		{raw: "True", want: "True"},
+		{raw: "42", want: 42},
+		{raw: "1.5", want: 1.5},
+		{raw: "dark", want: "dark"},
+		{raw: "42", kind: "string", want: "42"},
+		{raw: "42", kind: "int", want: 42},
+		{raw: "x", kind: "int", wantErr: true},
+		{raw: "0.25", kind: "float", want: 0.25},
+		{raw: "t", kind: "bool", want: true},
+		{raw: "a, b ,c", kind: "list", want: []string{"a", "b", "c"}},
+		{raw: "", kind: "list", want: []string{}},
+		{raw: "x", kind: "json", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseValue(tt.raw, tt.kind)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseValue(%q, %q) error = %v, wantErr %v", tt.raw, tt.kind, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseValue(%q, %q) = %#v, want %#v", tt.raw, tt.kind, got, tt.want)
+		}
+	}
+}
+func TestStoreSetUnsetSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.yaml")
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Set("theme", "light")
+	store.Set("paths.projects", "/src")
+	store.Set("paths.gobin", "/bin")
+	if !store.Unset("paths.gobin") {
+		t.Error("Unset(paths.gobin) = false, want true")
+	}
+	if store.Unset("paths.missing") {
+		t.Error("Unset(paths.missing) = true, want false")
+	}
+	if err := store.Save(); err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := reopened.Keys(), []string{"paths.projects", "theme", "version"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if value, ok := reopened.Get("theme"); !ok || value != "light" {
+		t.Errorf("Get(theme) = %v, %v", value, ok)
+	}
+}
+func TestStoreLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := os.WriteFile(path, []byte("version: 2\ntheme: dark\npaths:\n  projects: ~/code\n  gobin: /usr/local/bin\nkeymap:\n  Quit: q\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore() error = %v", err)
+	}
+	for key, want := range map[string]int{
+		"version":        1,
+		"theme":          2,
+		"paths":          3,
+		"paths.projects": 4,
+		"paths.gobin":    5,
+		"keymap.quit":    7,
+		"KEYMAP.Quit":    7,
+		"paths.unknown":  3,
+		"theme.nested":   2,
+		"unknown":        0,
+	} {
+		if got := store.Line(key); got != want {
+			t.Errorf("Line(%q) = %d, want %d", key, got, want)
+		}
+	}
+	missing, err := OpenStore(filepath.Join(t.TempDir(), FileName))
+	if err != nil {
+		t.Fatalf("OpenStore() error = %v", err)
+	}
+	if got := missing.Line("theme"); got != 0 {
+		t.Errorf("Line() on a missing file = %d, want 0", got)
+	}
+}
+func TestOpenStoreMigrates(t *testing.T) {
+	dir := t.TempDir()
+	legacy := filepath.Join(dir, "legacy.yaml")
+	current := filepath.Join(dir, "current.yaml")
+	os.WriteFile(legacy, []byte("theme: Dark\nkeymap:\n  navigate_languages: L\n"), 0o644)
+	os.WriteFile(current, []byte("version: 2\ntheme: Dark\n"), 0o644)
+	store, err := OpenStore(legacy)
+	if err != nil {
+		t.Fatalf("OpenStore(legacy) error = %v", err)
+	}
+	if from, migrated := store.Migrated(); !migrated || from != 0 {
+		t.Errorf("Migrated() = (%d, %v), want (0, true)", from, migrated)
+	}
+	if got, _ := store.Get("keymap.navigate_langs"); got != "L" {
+		t.Errorf("keymap.navigate_langs = %v, want L", got)
+	}
+	if got, _ := store.Get("theme"); got != "dark" {
+		t.Errorf("theme = %v, want dark", got)
+	}
+	store, err = OpenStore(current)
+	if err != nil {
+		t.Fatalf("OpenStore(current) error = %v", err)
+	}
+	if _, migrated := store.Migrated(); migrated {
+		t.Error("Migrated() = true for a versioned file")
+	}
+	if got, _ := store.Get("theme"); got != "Dark" {
+		t.Errorf("theme = %v, want Dark", got)
+	}
+}
+func TestValidate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	content := "version: 2\ncolour: red\ntheme: neon\nkeymap:\n  quit: ' , '\n  help: q\nwatch:\n  debounce: soon\nplugins:\n  path: lint\n  run: all\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore() error = %v", err)
+	}
+	RegisterValidator("watch", func(value any) []Issue {
+		if settings, _ := value.(map[string]any); settings["debounce"] == "soon" {
+			return []Issue{{Key: "watch.debounce", Message: "invalid duration"}}
+		}
+		return nil
+	})
+	t.Cleanup(func() { delete(validators, "watch") })
+	got := make(map[string]int)
+	for _, issue := range Validate(store) {
+		if issue.File != path {
+			t.Errorf("issue %q File = %q, want %q", issue.Key, issue.File, path)
+		}
+		got[issue.Key] = issue.Line
+	}
+	want := map[string]int{
+		"colour":         2,
+		"theme":          3,
+		"keymap.quit":    5,
+		"watch.debounce": 8,
+		"plugins.path":   10,
+		"plugins.run":    11,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() issue lines = %v, want %v", got, want)
+	}
+}
+func TestValidateIgnoresCase(t *testing.T) {
+	dir := t.TempDir()
+	for content, issues := range map[string]int{
+		"version: 2\ntheme: dark\n":        0,
+		"version: 2\ntheme: Dark\n":        0,
+		"version: 2\nkeymap_preset: Vim\n": 0,
+		"version: 2\ntheme: neon\n":        1,
+	} {
+		path := filepath.Join(dir, FileName)
+		os.WriteFile(path, []byte(content), 0o644)
+		store, err := OpenStore(path)
+		if err != nil {
+			t.Fatalf("OpenStore() error = %v", err)
+		}
+		if got := Validate(store); len(got) != issues {
+			t.Errorf("Validate(%q) = %v, want %d issues", content, got, issues)
+		}
+	}
+}
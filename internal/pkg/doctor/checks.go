@@ -0,0 +1,355 @@
+package doctor
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/x/term"
+	"github.com/danielscoffee/dev-tools/internal/pkg/configfile"
+)
+const (
+	minGoMajor       = 1
+	minGoMinor       = 22
+	minTerminalWidth = 80
+	minTerminalRows  = 24
+)
+func (d *Doctor) checkGoVersion(ctx context.Context) Check {
+	check := Check{Name: "Go toolchain"}
+	if _, err := exec.LookPath("go"); err != nil {
+		check.Status = StatusFail
+		check.Message = "go was not found on PATH"
+		check.Fix = "Install Go from https://go.dev/dl/ and add its bin directory to PATH"
+		return check
+	}
+	result := d.executor.Execute(ctx, "go", "env", "GOVERSION")
+	if result.Failed() {
+		check.Status = StatusFail
+		check.Message = "go env GOVERSION failed: " + strings.TrimSpace(result.Output())
+		check.Fix = "Reinstall Go from https://go.dev/dl/"
+		return check
+	}
+	version := strings.TrimSpace(result.Stdout)
+	check.Message = version
+	if !goVersionAtLeast(version, minGoMajor, minGoMinor) {
+		check.Status = StatusWarn
+		check.Fix = fmt.Sprintf("Upgrade Go to %d.%d or newer", minGoMajor, minGoMinor)
+		return check
+	}
+	check.Status = StatusPass
+	return check
+}
+func goVersionAtLeast(version string, major, minor int) bool {
+	parts := strings.SplitN(strings.TrimPrefix(version, "go"), ".", 3)
+	if len(parts) < 2 {
+		return strings.HasPrefix(version, "devel")
+	}
+	gotMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	digits := parts[1]
+	if i := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		digits = digits[:i]
+	}
+	gotMinor, err := strconv.Atoi(digits)
+	if err != nil {
+		return false
+	}
+	return gotMajor > major || (gotMajor == major && gotMinor >= minor)
+}
+func (d *Doctor) loadGoEnv(ctx context.Context) map[string]string {
+	result := d.executor.Execute(ctx, "go", "env", "-json", "GOPATH", "GOBIN", "GOPROXY", "GOFLAGS")
+	if result.Failed() {
+		return nil
+	}
+	env := make(map[string]string)
+	if err := json.Unmarshal([]byte(result.Stdout), &env); err != nil {
+		return nil
+	}
+	return env
+}
+func (d *Doctor) skipped(name string) Check {
+	return Check{
+		Name:    name,
+		Status:  StatusWarn,
+		Message: "skipped, go env is unavailable",
+		Fix:     "Fix the Go toolchain check first",
+	}
+}
+func (d *Doctor) checkGoPath() Check {
+	if d.goEnv == nil {
+		return d.skipped("GOPATH")
+	}
+	gopath := d.goEnv["GOPATH"]
+	if gopath == "" {
+		return Check{
+			Name:    "GOPATH",
+			Status:  StatusWarn,
+			Message: "GOPATH is empty",
+			Fix:     "go env -w GOPATH=$HOME/go",
+		}
+	}
+	return Check{Name: "GOPATH", Status: StatusPass, Message: gopath}
+}
+func (d *Doctor) binDir() string {
+	if d.goEnv == nil {
+		return ""
+	}
+	if gobin := d.goEnv["GOBIN"]; gobin != "" {
+		return gobin
+	}
+	paths := filepath.SplitList(d.goEnv["GOPATH"])
+	if len(paths) == 0 || paths[0] == "" {
+		return ""
+	}
+	return filepath.Join(paths[0], "bin")
+}
+func onPath(dir string) bool {
+	want := filepath.Clean(dir)
+	for _, entry := range filepath.SplitList(os.Getenv("PATH")) {
+		if entry != "" && filepath.Clean(entry) == want {
+			return true
+		}
+	}
+	return false
+}
+func pathFix(dir string) string {
+	if runtime.GOOS == "windows" {
+		return fmt.Sprintf("Add %s to your PATH in System Properties > Environment Variables", dir)
+	}
+	return fmt.Sprintf("Add to your shell profile: export PATH=\"$PATH:%s\"", dir)
+}
+func (d *Doctor) checkGoBin() Check {
+	if d.goEnv == nil {
+		return d.skipped("GOBIN on PATH")
+	}
+	dir := d.binDir()
+	if dir == "" {
+		return Check{
+			Name:    "GOBIN on PATH",
+			Status:  StatusWarn,
+			Message: "neither GOBIN nor GOPATH is set",
+			Fix:     "go env -w GOBIN=$HOME/go/bin",
+		}
+	}
+	if !onPath(dir) {
+		return Check{
+			Name:    "GOBIN on PATH",
+			Status:  StatusFail,
+			Message: dir + " is not on PATH, tools installed with go install will not be found",
+			Fix:     pathFix(dir),
+		}
+	}
+	return Check{Name: "GOBIN on PATH", Status: StatusPass, Message: dir}
+}
+func (d *Doctor) checkGoProxy() Check {
+	if d.goEnv == nil {
+		return d.skipped("GOPROXY")
+	}
+	proxy := d.goEnv["GOPROXY"]
+	switch proxy {
+	case "off":
+		return Check{
+			Name:    "GOPROXY",
+			Status:  StatusFail,
+			Message: "module downloads are disabled, go install cannot fetch go-blueprint",
+			Fix:     "go env -w GOPROXY=https://proxy.golang.org,direct",
+		}
+	case "", "direct":
+		return Check{
+			Name:    "GOPROXY",
+			Status:  StatusWarn,
+			Message: fmt.Sprintf("GOPROXY=%q bypasses the module proxy", proxy),
+			Fix:     "go env -w GOPROXY=https://proxy.golang.org,direct",
+		}
+	}
+	return Check{Name: "GOPROXY", Status: StatusPass, Message: proxy}
+}
+func (d *Doctor) checkGoFlags() Check {
+	if d.goEnv == nil {
+		return d.skipped("GOFLAGS")
+	}
+	flags := d.goEnv["GOFLAGS"]
+	if flags == "" {
+		return Check{Name: "GOFLAGS", Status: StatusPass, Message: "not set"}
+	}
+	if strings.Contains(flags, "-mod=vendor") {
+		return Check{
+			Name:    "GOFLAGS",
+			Status:  StatusWarn,
+			Message: "GOFLAGS=" + flags + " can break go install and go mod tidy in new projects",
+			Fix:     "go env -u GOFLAGS",
+		}
+	}
+	return Check{Name: "GOFLAGS", Status: StatusPass, Message: flags}
+}
+func (d *Doctor) checkBlueprint(ctx context.Context) Check {
+	check := Check{Name: "go-blueprint"}
+	if d.blueprint.IsInstalled() {
+		version, err := d.blueprint.Version(ctx)
+		if err != nil {
+			check.Status = StatusWarn
+			check.Message = "installed but `go-blueprint version` failed"
+			check.Fix = "go install " + d.blueprint.InstallTarget()
+			return check
+		}
+		check.Status = StatusPass
+		check.Message = version
+		if pinned := d.blueprint.TargetVersion(); pinned != "latest" && !strings.Contains(version, strings.TrimPrefix(pinned, "v")) {
+			check.Status = StatusWarn
+			check.Message = version + ", configured version is " + pinned
+			check.Fix = "go install " + d.blueprint.InstallTarget()
+		}
+		return check
+	}
+	if dir := d.binDir(); dir != "" {
+		name := "go-blueprint"
+		if runtime.GOOS == "windows" {
+			name += ".exe"
+		}
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Stat(candidate); err == nil {
+			check.Status = StatusFail
+			check.Message = "installed at " + candidate + " but not on PATH"
+			check.Fix = pathFix(dir)
+			return check
+		}
+	}
+	check.Status = StatusWarn
+	check.Message = "not installed"
+	check.Fix = "go install " + d.blueprint.InstallTarget()
+	return check
+}
+func (d *Doctor) checkConfig() Check {
+	check := d.checkConfigFile()
+	if err := configfile.LoadError(); err != nil && check.Status != StatusFail {
+		check.Status = StatusFail
+		check.Message = err.Error()
+		check.Fix = "Fix the config file or run 'dev-tools config validate' to list all issues"
+	}
+	return check
+}
+func (d *Doctor) checkConfigFile() Check {
+	check := Check{Name: "Config file"}
+	if d.configPath == "" {
+		check.Status = StatusWarn
+		check.Message = "config path is unknown"
+		check.Fix = "Make sure $HOME is set"
+		return check
+	}
+	if _, err := os.Stat(d.configPath); os.IsNotExist(err) {
+		check.Status = StatusPass
+		check.Message = d.configPath + " does not exist yet, defaults are used"
+		return check
+	}
+	store, err := configfile.OpenStore(d.configPath)
+	if err != nil {
+		check.Status = StatusFail
+		check.Message = err.Error()
+		check.Fix = "Fix the YAML syntax in " + d.configPath + " or delete the file to start over"
+		return check
+	}
+	if issues := configfile.Validate(store); len(issues) > 0 {
+		check.Status = StatusFail
+		check.Message = fmt.Sprintf("%s: %s", d.configPath, issues[0].Error())
+		check.Fix = "Run 'dev-tools config validate' to list all issues"
+		return check
+	}
+	if from, migrated := store.Migrated(); migrated {
+		check.Status = StatusWarn
+		check.Message = fmt.Sprintf("%s uses schema version %d", d.configPath, from)
+		check.Fix = "Run 'dev-tools config migrate' to upgrade it to version " + fmt.Sprint(configfile.SchemaVersion)
+		return check
+	}
+	check.Status = StatusPass
+	check.Message = fmt.Sprintf("%s (%d keys)", d.configPath, len(store.Keys()))
+	return check
+}
+func (d *Doctor) checkTTY() Check {
+	if !term.IsTerminal(os.Stdin.Fd()) || !term.IsTerminal(os.Stdout.Fd()) {
+		return Check{
+			Name:    "Terminal TTY",
+			Status:  StatusWarn,
+			Message: "stdin or stdout is not a terminal, `dev-tools tui` needs an interactive terminal",
+			Fix:     "Run dev-tools tui directly in a terminal, not through a pipe",
+		}
+	}
+	return Check{Name: "Terminal TTY", Status: StatusPass, Message: "interactive"}
+}
+func (d *Doctor) checkColorProfile() Check {
+	profile := colorprofile.Detect(os.Stdout, os.Environ())
+	switch profile {
+	case colorprofile.NoTTY, colorprofile.Ascii:
+		return Check{
+			Name:    "Color profile",
+			Status:  StatusWarn,
+			Message: profile.String() + ", the TUI will render without colors",
+			Fix:     "Set TERM=xterm-256color or COLORTERM=truecolor and unset NO_COLOR",
+		}
+	case colorprofile.ANSI:
+		return Check{
+			Name:    "Color profile",
+			Status:  StatusWarn,
+			Message: "ANSI, themes are limited to 16 colors",
+			Fix:     "Set TERM=xterm-256color or COLORTERM=truecolor",
+		}
+	}
+	return Check{Name: "Color profile", Status: StatusPass, Message: profile.String()}
+}
+func (d *Doctor) checkTerminalSize() Check {
+	width, height, err := term.GetSize(os.Stdout.Fd())
+	if err != nil {
+		return Check{
+			Name:    "Terminal size",
+			Status:  StatusWarn,
+			Message: "unable to determine terminal size",
+		}
+	}
+	message := fmt.Sprintf("%dx%d", width, height)
+	if width < minTerminalWidth || height < minTerminalRows {
+		return Check{
+			Name:    "Terminal size",
+			Status:  StatusWarn,
+			Message: message + ", smaller than the recommended size",
+			Fix:     fmt.Sprintf("Resize the terminal to at least %dx%d", minTerminalWidth, minTerminalRows),
+		}
+	}
+	return Check{Name: "Terminal size", Status: StatusPass, Message: message}
+}
+func (d *Doctor) checkWritable(dir string) Check {
+	check := Check{Name: "Writable " + dir}
+	existing := dir
+	for {
+		if _, err := os.Stat(existing); err == nil {
+			break
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			check.Status = StatusFail
+			check.Message = "no existing parent directory"
+			return check
+		}
+		existing = parent
+	}
+	file, err := os.CreateTemp(existing, ".dev-tools-doctor-*")
+	if err != nil {
+		check.Status = StatusFail
+		check.Message = err.Error()
+		check.Fix = "Check the permissions of " + existing
+		return check
+	}
+	file.Close()
+	os.Remove(file.Name())
+	check.Status = StatusPass
+	check.Message = "ok"
+	if existing != dir {
+		check.Message = "does not exist yet, will be created under " + existing
+	}
+	return check
+}
@@ -0,0 +1,104 @@
+package doctor
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+func TestGoVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"go1.22.0", true},
+		{"go1.22", true},
+		{"go1.23.4", true},
+		{"go1.22rc1", true},
+		{"go2.0", true},
+		{"go1.21.13", false},
+		{"go1.9", false},
+		{"devel +abc123", true},
+		{"gox.y", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := goVersionAtLeast(tt.version, 1, 22); got != tt.want {
+			t.Errorf("goVersionAtLeast(%q, 1, 22) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+func TestCheckGoEnv(t *testing.T) {
+	d := NewDoctor()
+	if check := d.checkGoPath(); check.Status != StatusWarn || !strings.Contains(check.Message, "skipped") {
+		t.Errorf("checkGoPath() without go env = %+v, want a skipped warning", check)
+	}
+	d.goEnv = map[string]string{"GOPATH": ""}
+	if check := d.checkGoPath(); check.Status != StatusWarn || check.Fix == "" {
+		t.Errorf("checkGoPath() with empty GOPATH = %+v, want a warning with a fix", check)
+	}
+	d.goEnv = map[string]string{"GOPATH": "/home/gopher/go" + string(filepath.ListSeparator) + "/opt/go"}
+	if got, want := d.binDir(), filepath.Join("/home/gopher/go", "bin"); got != want {
+		t.Errorf("binDir() = %q, want %q", got, want)
+	}
+	d.goEnv["GOBIN"] = "/usr/local/gobin"
+	if got := d.binDir(); got != "/usr/local/gobin" {
+		t.Errorf("binDir() with GOBIN = %q, want /usr/local/gobin", got)
+	}
+	t.Setenv("PATH", "/usr/bin"+string(filepath.ListSeparator)+"/usr/local/gobin/")
+	if check := d.checkGoBin(); check.Status != StatusPass {
+		t.Errorf("checkGoBin() = %+v, want pass", check)
+	}
+	t.Setenv("PATH", "/usr/bin")
+	if check := d.checkGoBin(); check.Status != StatusFail || !strings.Contains(check.Fix, "/usr/local/gobin") {
+		t.Errorf("checkGoBin() off PATH = %+v, want a failure naming the directory", check)
+	}
+}
+func TestCheckConfig(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.yaml")
+	broken := filepath.Join(dir, "broken.yaml")
+	legacy := filepath.Join(dir, "legacy.yaml")
+	invalid := filepath.Join(dir, "invalid.yaml")
+	os.WriteFile(valid, []byte("version: 2\ntheme: light\n"), 0o644)
+	os.WriteFile(broken, []byte("theme: [light\n"), 0o644)
+	os.WriteFile(legacy, []byte("theme: Light\nkeymap:\n  navigate_languages: L\n"), 0o644)
+	os.WriteFile(invalid, []byte("version: 2\ntheme: neon\n"), 0o644)
+	tests := []struct {
+		name   string
+		path   string
+		status Status
+	}{
+		{"unknown path", "", StatusWarn},
+		{"missing file", filepath.Join(dir, "missing.yaml"), StatusPass},
+		{"valid file", valid, StatusPass},
+		{"broken file", broken, StatusFail},
+		{"file needing a migration", legacy, StatusWarn},
+		{"invalid value", invalid, StatusFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := NewDoctor().WithConfigPath(tt.path).checkConfig()
+			if check.Status != tt.status {
+				t.Errorf("checkConfig() = %+v, want %s", check, tt.status)
+			}
+		})
+	}
+}
+func TestCheckWritable(t *testing.T) {
+	dir := t.TempDir()
+	if check := NewDoctor().checkWritable(dir); check.Status != StatusPass || check.Message != "ok" {
+		t.Errorf("checkWritable(existing) = %+v", check)
+	}
+	nested := filepath.Join(dir, "a", "b")
+	check := NewDoctor().checkWritable(nested)
+	if check.Status != StatusPass || !strings.HasSuffix(check.Message, dir) {
+		t.Errorf("checkWritable(nested) = %+v, want pass under %s", check, dir)
+	}
+	if _, err := os.Stat(nested); !os.IsNotExist(err) {
+		t.Errorf("checkWritable created %s", nested)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("checkWritable left %d files behind", len(entries))
+	}
+}
@@ -0,0 +1,119 @@
+// Package doctor provides environment diagnostics for dev-tools
+package doctor
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/backend/golang"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+)
+type Status string
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+func (s Status) Symbol() string {
+	switch s {
+	case StatusPass:
+		return "✅"
+	case StatusWarn:
+		return "⚠️ "
+	default:
+		return "❌"
+	}
+}
+type Check struct {
+	Name    string `json:"name" yaml:"name"`
+	Status  Status `json:"status" yaml:"status"`
+	Message string `json:"message" yaml:"message"`
+	Fix     string `json:"fix,omitempty" yaml:"fix,omitempty"`
+}
+type Report struct {
+	Checks []Check `json:"checks" yaml:"checks"`
+}
+func (r *Report) Add(check Check) {
+	r.Checks = append(r.Checks, check)
+}
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, check := range r.Checks {
+		if check.Status == status {
+			count++
+		}
+	}
+	return count
+}
+func (r *Report) Failed() bool {
+	return r.Count(StatusFail) > 0
+}
+func (r *Report) Text(w io.Writer) {
+	fmt.Fprintln(w, "🩺 dev-tools doctor")
+	fmt.Fprintln(w)
+	for _, check := range r.Checks {
+		fmt.Fprintf(w, "%s %-22s %s\n", check.Status.Symbol(), check.Name, check.Message)
+		if check.Fix != "" && check.Status != StatusPass {
+			fmt.Fprintf(w, "   ↳ %s\n", check.Fix)
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%d passed, %d warnings, %d failed\n",
+		r.Count(StatusPass),
+		r.Count(StatusWarn),
+		r.Count(StatusFail),
+	)
+}
+type Doctor struct {
+	executor   *executor.CommandExecutor
+	blueprint  *golang.Blueprint
+	configPath string
+	targetDirs []string
+	goEnv      map[string]string
+}
+func NewDoctor() *Doctor {
+	return &Doctor{
+		executor:  executor.NewExecutor().WithTimeout(10 * time.Second),
+		blueprint: golang.NewBlueprint(),
+	}
+}
+func (d *Doctor) WithConfigPath(path string) *Doctor {
+	d.configPath = path
+	return d
+}
+func (d *Doctor) WithBlueprint(blueprint *golang.Blueprint) *Doctor {
+	d.blueprint = blueprint
+	return d
+}
+func (d *Doctor) WithTargetDirs(dirs ...string) *Doctor {
+	d.targetDirs = append(d.targetDirs, dirs...)
+	return d
+}
+func (d *Doctor) Run(ctx context.Context) *Report {
+	report := &Report{}
+	report.Add(d.checkGoVersion(ctx))
+	d.goEnv = d.loadGoEnv(ctx)
+	report.Add(d.checkGoPath())
+	report.Add(d.checkGoBin())
+	report.Add(d.checkGoProxy())
+	report.Add(d.checkGoFlags())
+	report.Add(d.checkBlueprint(ctx))
+	report.Add(d.checkConfig())
+	report.Add(d.checkTTY())
+	report.Add(d.checkColorProfile())
+	report.Add(d.checkTerminalSize())
+	dirs := d.targetDirs
+	if len(dirs) == 0 {
+		if cwd, err := os.Getwd(); err == nil {
+			dirs = append(dirs, cwd)
+		}
+	}
+	if binDir := d.binDir(); binDir != "" {
+		dirs = append(dirs, binDir)
+	}
+	for _, dir := range dirs {
+		report.Add(d.checkWritable(dir))
+	}
+	return report
+}
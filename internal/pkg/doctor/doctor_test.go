@@ -0,0 +1,41 @@
+package doctor
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+func TestReport(t *testing.T) {
+	report := &Report{}
+	report.Add(Check{Name: "Go toolchain", Status: StatusPass, Message: "go1.24.0"})
+	report.Add(Check{Name: "GOPROXY", Status: StatusWarn, Message: "off", Fix: "go env -w GOPROXY=https://proxy.golang.org,direct"})
+	if report.Failed() {
+		t.Fatal("Failed() = true without failing checks")
+	}
+	report.Add(Check{Name: "go-blueprint", Status: StatusFail, Message: "not installed", Fix: "dev-tools golang install"})
+	if !report.Failed() {
+		t.Fatal("Failed() = false with a failing check")
+	}
+	if got := [3]int{report.Count(StatusPass), report.Count(StatusWarn), report.Count(StatusFail)}; got != [3]int{1, 1, 1} {
+		t.Errorf("counts = %v, want [1 1 1]", got)
+	}
+	data, err := json.Marshal(report.Checks[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), `{"name":"Go toolchain","status":"pass","message":"go1.24.0"}`; got != want {
+		t.Errorf("json = %s, want %s", got, want)
+	}
+	var buf bytes.Buffer
+	report.Text(&buf)
+	for _, want := range []string{
+		"✅ Go toolchain           go1.24.0\n",
+		"   ↳ go env -w GOPROXY=https://proxy.golang.org,direct\n",
+		"❌ go-blueprint           not installed\n   ↳ dev-tools golang install\n",
+		"1 passed, 1 warnings, 1 failed\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Text() is missing %q in:\n%s", want, buf.String())
+		}
+	}
+}
@@ -4,15 +4,21 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"runtime"
 	"strings"
 	"time"
 )
+const WaitDelay = time.Second
 type CommandExecutor struct {
 	WorkingDir string
 	Timeout    time.Duration
 	Env        []string
+	Stdin      io.Reader
+	Stdout     io.Writer
+	Stderr     io.Writer
 }
 func NewExecutor() *CommandExecutor {
 	return &CommandExecutor{
@@ -33,6 +39,15 @@ func (e *CommandExecutor) WithEnv(env []string) *CommandExecutor {
 	e.Env = env
 	return e
 }
+func (e *CommandExecutor) WithStdin(stdin io.Reader) *CommandExecutor {
+	e.Stdin = stdin
+	return e
+}
+func (e *CommandExecutor) WithOutput(stdout, stderr io.Writer) *CommandExecutor {
+	e.Stdout = stdout
+	e.Stderr = stderr
+	return e
+}
 type CommandResult struct {
 	Command    string
 	Args       []string
@@ -53,9 +68,17 @@ func (e *CommandExecutor) Execute(ctx context.Context, command string, args ...s
 	cmd := exec.CommandContext(ctx, command, args...)
 	cmd.Dir = e.WorkingDir
 	cmd.Env = e.Env
+	cmd.Stdin = e.Stdin
+	cmd.WaitDelay = WaitDelay
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
+	if e.Stdout != nil {
+		cmd.Stdout = io.MultiWriter(&stdout, e.Stdout)
+	}
+	if e.Stderr != nil {
+		cmd.Stderr = io.MultiWriter(&stderr, e.Stderr)
+	}
 	err := cmd.Run()
 	duration := time.Since(start)
 	result := &CommandResult{
@@ -77,17 +100,39 @@ func (e *CommandExecutor) Execute(ctx context.Context, command string, args ...s
 	return result
 }
 func (e *CommandExecutor) ExecuteShell(ctx context.Context, command string) *CommandResult {
+	if runtime.GOOS == "windows" {
+		return e.Execute(ctx, "cmd", "/C", command)
+	}
 	return e.Execute(ctx, "sh", "-c", command)
 }
-func (e *CommandExecutor) ExecuteInteractive(ctx context.Context, command string, args ...string) error {
+func (e *CommandExecutor) Command(ctx context.Context, command string, args ...string) *exec.Cmd {
 	cmd := exec.CommandContext(ctx, command, args...)
 	cmd.Dir = e.WorkingDir
 	cmd.Env = e.Env
+	cmd.Stdin = e.Stdin
+	cmd.Stdout = e.Stdout
+	cmd.Stderr = e.Stderr
+	return cmd
+}
+func (e *CommandExecutor) ExecuteInteractive(ctx context.Context, command string, args ...string) error {
+	cmd := e.Command(ctx, command, args...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
+func (e *CommandExecutor) Start(command string, args ...string) (*exec.Cmd, error) {
+	cmd := exec.Command(command, args...)
+	cmd.Dir = e.WorkingDir
+	cmd.Env = e.Env
+	cmd.Stdin = e.Stdin
+	cmd.Stdout = e.Stdout
+	cmd.Stderr = e.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
 func (r *CommandResult) String() string {
 	var output strings.Builder
 	output.WriteString(fmt.Sprintf("Command: %s %s\n", r.Command, strings.Join(r.Args, " ")))
@@ -126,3 +171,14 @@ func (r *CommandResult) Output() string {
 	}
 	return output.String()
 }
+func Editor() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
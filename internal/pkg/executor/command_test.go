@@ -0,0 +1,44 @@
+package executor
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+func TestExecuteShell(t *testing.T) {
+	result := NewExecutor().ExecuteShell(context.Background(), "echo hello && exit 3")
+	want := "sh"
+	if runtime.GOOS == "windows" {
+		want = "cmd"
+	}
+	if result.Command != want {
+		t.Errorf("Command = %q, want %q", result.Command, want)
+	}
+	if strings.TrimSpace(result.Stdout) != "hello" || result.ExitCode != 3 {
+		t.Errorf("ExecuteShell() = stdout %q, exit %d, want hello and 3", result.Stdout, result.ExitCode)
+	}
+}
+func TestEditor(t *testing.T) {
+	fallback := "vi"
+	if runtime.GOOS == "windows" {
+		fallback = "notepad"
+	}
+	tests := []struct {
+		visual string
+		editor string
+		want   []string
+	}{
+		{want: []string{fallback}},
+		{editor: "nano", want: []string{"nano"}},
+		{visual: "code --wait", editor: "nano", want: []string{"code", "--wait"}},
+		{visual: "  ", editor: "hx", want: []string{"hx"}},
+	}
+	for _, tt := range tests {
+		t.Setenv("VISUAL", tt.visual)
+		t.Setenv("EDITOR", tt.editor)
+		if got := Editor(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Editor() with VISUAL=%q EDITOR=%q = %q, want %q", tt.visual, tt.editor, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package executor
+import (
+	"bytes"
+	"strings"
+	"sync"
+)
+type LineWriter struct {
+	mu      sync.Mutex
+	pending []byte
+	all     bytes.Buffer
+	emit    func(string)
+}
+func NewLineWriter(emit func(line string)) *LineWriter {
+	return &LineWriter{emit: emit}
+}
+func (w *LineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.all.Write(p)
+	w.pending = append(w.pending, p...)
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			break
+		}
+		w.emit(strings.TrimRight(string(w.pending[:i]), "\r"))
+		w.pending = w.pending[i+1:]
+	}
+	return len(p), nil
+}
+func (w *LineWriter) WriteLine(line string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.all.WriteString(line + "\n")
+	w.emit(line)
+}
+func (w *LineWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.pending) > 0 {
+		w.emit(string(w.pending))
+		w.all.WriteByte('\n')
+		w.pending = nil
+	}
+}
+func (w *LineWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.all.String()
+}
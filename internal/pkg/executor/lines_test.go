@@ -0,0 +1,27 @@
+package executor
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+func TestLineWriter(t *testing.T) {
+	var lines []string
+	w := NewLineWriter(func(line string) {
+		lines = append(lines, line)
+	})
+	fmt.Fprint(w, "first\r\nsec")
+	fmt.Fprint(w, "ond\n")
+	w.WriteLine("$ go build")
+	fmt.Fprint(w, "partial")
+	if want := []string{"first", "second", "$ go build"}; !slices.Equal(lines, want) {
+		t.Errorf("lines before Flush = %q, want %q", lines, want)
+	}
+	w.Flush()
+	w.Flush()
+	if want := []string{"first", "second", "$ go build", "partial"}; !slices.Equal(lines, want) {
+		t.Errorf("lines after Flush = %q, want %q", lines, want)
+	}
+	if got, want := w.String(), "first\r\nsecond\n$ go build\npartial\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,30 @@
+package output
+const (
+	CodeInternal          = "internal"
+	CodeInvalidArgument   = "invalid_argument"
+	CodeUsage             = "usage"
+	CodeNotFound          = "not_found"
+	CodeConfig            = "config_invalid"
+	CodeDependencyMissing = "dependency_missing"
+	CodeCommandFailed     = "command_failed"
+	CodeChecksFailed      = "checks_failed"
+)
+type Error struct {
+	Code     string `json:"code" yaml:"code"`
+	Message  string `json:"message" yaml:"message"`
+	Hint     string `json:"hint,omitempty" yaml:"hint,omitempty"`
+	ExitCode int    `json:"-" yaml:"-"`
+}
+func NewError(code, message, hint string) *Error {
+	return &Error{Code: code, Message: message, Hint: hint, ExitCode: 1}
+}
+func Wrap(err error, code, hint string) *Error {
+	return NewError(code, err.Error(), hint)
+}
+func (e *Error) Error() string {
+	return e.Message
+}
+func (e *Error) WithExitCode(code int) *Error {
+	e.ExitCode = code
+	return e
+}
@@ -0,0 +1,103 @@
+// Package output renders command results and errors as text, JSON or YAML
+package output
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"gopkg.in/yaml.v3"
+)
+type Format string
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+const SchemaVersion = "dev-tools/v1"
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(value)) {
+	case FormatText, "":
+		return FormatText, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatYAML, "yml":
+		return FormatYAML, nil
+	}
+	return "", NewError(CodeInvalidArgument, fmt.Sprintf("unknown output format %q", value), "Use --output text, json or yaml")
+}
+type Texter interface {
+	Text(w io.Writer)
+}
+type Envelope struct {
+	SchemaVersion string `json:"schemaVersion" yaml:"schemaVersion"`
+	Command       string `json:"command" yaml:"command"`
+	OK            bool   `json:"ok" yaml:"ok"`
+	Data          any    `json:"data,omitempty" yaml:"data,omitempty"`
+	Error         *Error `json:"error,omitempty" yaml:"error,omitempty"`
+}
+type Renderer struct {
+	Format Format
+	Out    io.Writer
+	Err    io.Writer
+}
+func NewRenderer(format Format, out, errOut io.Writer) *Renderer {
+	return &Renderer{Format: format, Out: out, Err: errOut}
+}
+func (r *Renderer) Render(command string, data any, err error) error {
+	var outErr *Error
+	if err != nil {
+		outErr = AsError(err)
+	}
+	switch r.Format {
+	case FormatJSON, FormatYAML:
+		return r.encode(Envelope{
+			SchemaVersion: SchemaVersion,
+			Command:       command,
+			OK:            outErr == nil,
+			Data:          data,
+			Error:         outErr,
+		})
+	}
+	if data != nil {
+		r.renderText(data)
+	}
+	if outErr != nil {
+		fmt.Fprintf(r.Err, "Error: %s\n", outErr.Message)
+		if outErr.Hint != "" {
+			fmt.Fprintf(r.Err, "   ↳ %s\n", outErr.Hint)
+		}
+	}
+	return nil
+}
+func (r *Renderer) renderText(data any) {
+	switch v := data.(type) {
+	case Texter:
+		v.Text(r.Out)
+	case string:
+		fmt.Fprintln(r.Out, v)
+	default:
+		fmt.Fprintf(r.Out, "%v\n", v)
+	}
+}
+func (r *Renderer) encode(envelope Envelope) error {
+	if r.Format == FormatYAML {
+		encoder := yaml.NewEncoder(r.Out)
+		encoder.SetIndent(2)
+		defer encoder.Close()
+		return encoder.Encode(envelope)
+	}
+	encoder := json.NewEncoder(r.Out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(envelope)
+}
+func (r *Renderer) Structured() bool {
+	return r.Format == FormatJSON || r.Format == FormatYAML
+}
+func AsError(err error) *Error {
+	var outErr *Error
+	if errors.As(err, &outErr) {
+		return outErr
+	}
+	return &Error{Code: CodeInternal, Message: err.Error(), ExitCode: 1}
+}
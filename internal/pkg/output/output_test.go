@@ -0,0 +1,153 @@
+package output
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"gopkg.in/yaml.v3"
+)
+type report struct {
+	Name string `json:"name" yaml:"name"`
+}
+func (r report) Text(w io.Writer) {
+	fmt.Fprintln(w, "report "+r.Name)
+}
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Format
+		wantErr bool
+	}{
+		{value: "", want: FormatText},
+		{value: "text", want: FormatText},
+		{value: "JSON", want: FormatJSON},
+		{value: "yaml", want: FormatYAML},
+		{value: "yml", want: FormatYAML},
+		{value: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseFormat(tt.value)
+			if tt.wantErr {
+				if AsError(err).Code != CodeInvalidArgument {
+					t.Errorf("ParseFormat() error = %v, want %s", err, CodeInvalidArgument)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseFormat() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+func TestRenderEnvelope(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		data   any
+		err    error
+		want   Envelope
+	}{
+		{
+			name:   "json data",
+			format: FormatJSON,
+			data:   report{Name: "doctor"},
+			want:   Envelope{SchemaVersion: SchemaVersion, Command: "doctor", OK: true, Data: map[string]any{"name": "doctor"}},
+		},
+		{
+			name:   "json error",
+			format: FormatJSON,
+			err:    NewError(CodeNotFound, "task missing", "Run 'dev-tools run --list'"),
+			want:   Envelope{SchemaVersion: SchemaVersion, Command: "doctor", Error: &Error{Code: CodeNotFound, Message: "task missing", Hint: "Run 'dev-tools run --list'"}},
+		},
+		{
+			name:   "json wrapped error",
+			format: FormatJSON,
+			err:    fmt.Errorf("loading: %w", NewError(CodeConfig, "bad config", "")),
+			want:   Envelope{SchemaVersion: SchemaVersion, Command: "doctor", Error: &Error{Code: CodeConfig, Message: "bad config"}},
+		},
+		{
+			name:   "yaml plain error",
+			format: FormatYAML,
+			err:    errors.New("boom"),
+			want:   Envelope{SchemaVersion: SchemaVersion, Command: "doctor", Error: &Error{Code: CodeInternal, Message: "boom"}},
+		},
+		{
+			name:   "yaml data and error",
+			format: FormatYAML,
+			data:   report{Name: "doctor"},
+			err:    NewError(CodeChecksFailed, "1 check failed", ""),
+			want:   Envelope{SchemaVersion: SchemaVersion, Command: "doctor", Data: map[string]any{"name": "doctor"}, Error: &Error{Code: CodeChecksFailed, Message: "1 check failed"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+			if err := NewRenderer(tt.format, &out, &errOut).Render("doctor", tt.data, tt.err); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if errOut.Len() > 0 {
+				t.Errorf("stderr = %q, want nothing", errOut.String())
+			}
+			var got Envelope
+			var err error
+			if tt.format == FormatYAML {
+				err = yaml.Unmarshal(out.Bytes(), &got)
+			} else {
+				err = json.Unmarshal(out.Bytes(), &got)
+			}
+			if err != nil {
+				t.Fatalf("decode %q: %v", out.String(), err)
+			}
+			if got.SchemaVersion != tt.want.SchemaVersion || got.Command != tt.want.Command || got.OK != tt.want.OK {
+				t.Errorf("envelope = %+v, want %+v", got, tt.want)
+			}
+			if fmt.Sprint(got.Data) != fmt.Sprint(tt.want.Data) {
+				t.Errorf("data = %v, want %v", got.Data, tt.want.Data)
+			}
+			if (got.Error == nil) != (tt.want.Error == nil) || got.Error != nil && *got.Error != *tt.want.Error {
+				t.Errorf("error = %+v, want %+v", got.Error, tt.want.Error)
+			}
+		})
+	}
+}
+func TestRenderText(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    any
+		err     error
+		wantOut string
+		wantErr string
+	}{
+		{name: "texter", data: report{Name: "doctor"}, wantOut: "report doctor\n"},
+		{name: "string", data: "done", wantOut: "done\n"},
+		{name: "error with hint", err: NewError(CodeUsage, "bad flag", "See --help"), wantErr: "Error: bad flag\n   ↳ See --help\n"},
+		{name: "plain error", err: errors.New("boom"), wantErr: "Error: boom\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+			if err := NewRenderer(FormatText, &out, &errOut).Render("doctor", tt.data, tt.err); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if out.String() != tt.wantOut {
+				t.Errorf("stdout = %q, want %q", out.String(), tt.wantOut)
+			}
+			if errOut.String() != tt.wantErr {
+				t.Errorf("stderr = %q, want %q", errOut.String(), tt.wantErr)
+			}
+		})
+	}
+}
+func TestEnvelopeOmitsEmptyFields(t *testing.T) {
+	var out bytes.Buffer
+	NewRenderer(FormatJSON, &out, io.Discard).Render("config get", nil, nil)
+	for _, field := range []string{`"data"`, `"error"`} {
+		if strings.Contains(out.String(), field) {
+			t.Errorf("envelope %s contains %s", out.String(), field)
+		}
+	}
+}
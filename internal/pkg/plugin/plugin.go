@@ -0,0 +1,123 @@
+// Package plugin discovers dev-tools-<name> executables and talks to them over a JSON protocol
+package plugin
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+	"github.com/danielscoffee/dev-tools/internal/pkg/xdg"
+)
+const Prefix = "dev-tools-"
+type Plugin struct {
+	Name string `json:"name" yaml:"name"`
+	Path string `json:"path" yaml:"path"`
+}
+func Dir() (string, error) {
+	dir, err := xdg.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "plugins"), nil
+}
+func legacyDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".dev-tools", "plugins"), nil
+}
+func Dirs() []string {
+	var dirs []string
+	if dir, err := Dir(); err == nil {
+		dirs = append(dirs, dir)
+	}
+	if dir, err := legacyDir(); err == nil {
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+func DefaultDirs() []string {
+	return append(Dirs(), filepath.SplitList(os.Getenv("PATH"))...)
+}
+func Enabled(names []string) []Plugin {
+	plugins := Discover(Dirs()...)
+	seen := make(map[string]bool)
+	for _, p := range plugins {
+		seen[p.Name] = true
+	}
+	for _, p := range Discover(filepath.SplitList(os.Getenv("PATH"))...) {
+		if !seen[p.Name] && contains(names, p.Name) {
+			plugins = append(plugins, p)
+		}
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+	return plugins
+}
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+func Discover(dirs ...string) []Plugin {
+	seen := make(map[string]bool)
+	var plugins []Plugin
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || seen[name] || entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			seen[name] = true
+			plugins = append(plugins, Plugin{Name: name, Path: path})
+		}
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+	return plugins
+}
+func pluginName(file string) (string, bool) {
+	if !strings.HasPrefix(file, Prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(file, Prefix)
+	if runtime.GOOS == "windows" {
+		if !strings.HasSuffix(strings.ToLower(name), ".exe") {
+			return "", false
+		}
+		name = name[:len(name)-len(".exe")]
+	}
+	return name, name != ""
+}
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+func (p Plugin) Run(ctx context.Context, args ...string) error {
+	return executor.NewExecutor().ExecuteInteractive(ctx, p.Path, args...)
+}
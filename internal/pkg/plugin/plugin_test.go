@@ -0,0 +1,64 @@
+package plugin
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+func writePlugin(t *testing.T, dir, file, script string, mode os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, file)
+	if err := os.WriteFile(path, []byte(script), mode); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are found by the .exe suffix on windows")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	deploy := writePlugin(t, first, "dev-tools-deploy", "#!/bin/sh\n", 0o755)
+	writePlugin(t, first, "dev-tools-notes", "", 0o644)
+	writePlugin(t, first, "other-tool", "#!/bin/sh\n", 0o755)
+	writePlugin(t, first, "dev-tools-", "#!/bin/sh\n", 0o755)
+	if err := os.Mkdir(filepath.Join(first, "dev-tools-dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writePlugin(t, second, "dev-tools-deploy", "#!/bin/sh\n", 0o755)
+	lint := writePlugin(t, second, "dev-tools-lint", "#!/bin/sh\n", 0o755)
+	got := Discover("", first, filepath.Join(first, "missing"), second)
+	want := []Plugin{{Name: "deploy", Path: deploy}, {Name: "lint", Path: lint}}
+	if len(got) != len(want) {
+		t.Fatalf("Discover() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Discover()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+func TestEnabled(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are found by the .exe suffix on windows")
+	}
+	config, path := t.TempDir(), t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("PATH", path)
+	plugins := filepath.Join(config, "dev-tools", "plugins")
+	if err := os.MkdirAll(plugins, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	deploy := writePlugin(t, plugins, "dev-tools-deploy", "#!/bin/sh\n", 0o755)
+	writePlugin(t, path, "dev-tools-deploy", "#!/bin/sh\n", 0o755)
+	lint := writePlugin(t, path, "dev-tools-lint", "#!/bin/sh\n", 0o755)
+	writePlugin(t, path, "dev-tools-wipe", "#!/bin/sh\n", 0o755)
+	got := Enabled([]string{"lint", "deploy"})
+	if len(got) != 2 || got[0] != (Plugin{Name: "deploy", Path: deploy}) || got[1] != (Plugin{Name: "lint", Path: lint}) {
+		t.Errorf("Enabled() = %+v, want deploy from the plugins directory and lint from PATH", got)
+	}
+	if got := Enabled(nil); len(got) != 1 || got[0].Name != "deploy" {
+		t.Errorf("Enabled(nil) = %+v, want only the plugins directory", got)
+	}
+}
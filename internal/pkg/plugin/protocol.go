@@ -0,0 +1,67 @@
+package plugin
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+)
+const (
+	ProtocolArg     = "__dev-tools-tui"
+	ProtocolVersion = 1
+)
+const (
+	RequestDescribe = "describe"
+	RequestRender   = "render"
+	RequestKey      = "key"
+)
+type PageInfo struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Key         string `json:"key,omitempty"`
+}
+type Request struct {
+	Version int    `json:"version"`
+	Type    string `json:"type"`
+	Page    string `json:"page,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Width   int    `json:"width,omitempty"`
+	Height  int    `json:"height,omitempty"`
+}
+type Response struct {
+	Pages   []PageInfo `json:"pages,omitempty"`
+	Content string     `json:"content,omitempty"`
+	Error   string     `json:"error,omitempty"`
+}
+func (p Plugin) Call(ctx context.Context, req Request) (*Response, error) {
+	req.Version = ProtocolVersion
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	result := executor.NewExecutor().
+		WithTimeout(5*time.Second).
+		WithStdin(bytes.NewReader(append(payload, '\n'))).
+		Execute(ctx, p.Path, ProtocolArg)
+	if result.Failed() {
+		return nil, fmt.Errorf("plugin %s: %s", p.Name, strings.TrimSpace(result.Output()))
+	}
+	var resp Response
+	if err := json.Unmarshal([]byte(result.Stdout), &resp); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid response: %w", p.Name, err)
+	}
+	if resp.Error != "" {
+		return &resp, fmt.Errorf("plugin %s: %s", p.Name, resp.Error)
+	}
+	return &resp, nil
+}
+func (p Plugin) Describe(ctx context.Context) ([]PageInfo, error) {
+	resp, err := p.Call(ctx, Request{Type: RequestDescribe})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Pages, nil
+}
@@ -0,0 +1,58 @@
+package plugin
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+)
+func TestCall(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake plugin is a shell script")
+	}
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		script  string
+		pages   int
+		wantErr string
+	}{
+		{
+			name:   "describe",
+			script: `read req; case "$req" in *'"version":1'*'"type":"describe"'*) echo '{"pages":[{"id":"deploy","title":"Deploy","key":"d"}]}';; *) echo '{"error":"bad request"}';; esac`,
+			pages:  1,
+		},
+		{
+			name:    "error response",
+			script:  `echo '{"error":"not ready"}'`,
+			wantErr: "plugin fake: not ready",
+		},
+		{
+			name:    "invalid json",
+			script:  `echo 'hello'`,
+			wantErr: "invalid response",
+		},
+		{
+			name:    "exit status",
+			script:  `echo broken >&2; exit 3`,
+			wantErr: "broken",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Plugin{Name: "fake", Path: writePlugin(t, dir, "dev-tools-"+strings.ReplaceAll(tt.name, " ", "-"), "#!/bin/sh\n"+tt.script+"\n", 0o755)}
+			pages, err := p.Describe(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Describe() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Describe() error = %v", err)
+			}
+			if len(pages) != tt.pages || pages[0].ID != "deploy" || pages[0].Key != "d" {
+				t.Errorf("Describe() = %+v", pages)
+			}
+		})
+	}
+}
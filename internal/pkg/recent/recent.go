@@ -0,0 +1,66 @@
+// Package recent remembers the projects dev-tools created or opened
+package recent
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/xdg"
+)
+const MaxProjects = 20
+type Project struct {
+	Name   string    `json:"name" yaml:"name"`
+	Path   string    `json:"path" yaml:"path"`
+	UsedAt time.Time `json:"used_at" yaml:"used_at"`
+}
+func Path() (string, error) {
+	dir, err := xdg.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "recent.json"), nil
+}
+func List() ([]Project, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var projects []Project
+	if err := json.Unmarshal(data, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+func Add(dir string) error {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	projects, _ := List()
+	updated := []Project{{Name: filepath.Base(abs), Path: abs, UsedAt: time.Now()}}
+	for _, project := range projects {
+		if project.Path != abs && len(updated) < MaxProjects {
+			updated = append(updated, project)
+		}
+	}
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(updated, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
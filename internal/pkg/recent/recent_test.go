@@ -0,0 +1,36 @@
+package recent
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+func TestAdd(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	if projects, err := List(); err != nil || len(projects) != 0 {
+		t.Fatalf("List() without a file = (%v, %v)", projects, err)
+	}
+	root := t.TempDir()
+	for i := 0; i < MaxProjects+2; i++ {
+		if err := Add(filepath.Join(root, fmt.Sprintf("app%d", i))); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	Add(filepath.Join(root, "app5"))
+	projects, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(projects) != MaxProjects {
+		t.Fatalf("len(List()) = %d, want %d", len(projects), MaxProjects)
+	}
+	if projects[0].Name != "app5" || projects[1].Name != "app21" {
+		t.Errorf("List() starts with %s, %s, want app5, app21", projects[0].Name, projects[1].Name)
+	}
+	seen := map[string]bool{}
+	for _, project := range projects {
+		if seen[project.Path] {
+			t.Errorf("%s is listed twice", project.Path)
+		}
+		seen[project.Path] = true
+	}
+}
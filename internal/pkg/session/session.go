@@ -0,0 +1,75 @@
+// Package session remembers where the TUI was left so the next launch can restore it
+package session
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/xdg"
+)
+type Session struct {
+	Route   string                `json:"route"`
+	History []string              `json:"history,omitempty"`
+	Theme   string                `json:"theme,omitempty"`
+	Scroll  map[string]int        `json:"scroll,omitempty"`
+	Drafts  map[string]url.Values `json:"drafts,omitempty"`
+	SavedAt time.Time             `json:"saved_at"`
+}
+func (s *Session) Empty() bool {
+	return (s.Route == "" || s.Route == "/") && len(s.Drafts) == 0
+}
+func Path() (string, error) {
+	dir, err := xdg.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "session.json"), nil
+}
+func Load() (*Session, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+func Save(s *Session) error {
+	if s.Empty() {
+		return Clear()
+	}
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	s.SavedAt = time.Now()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+func Clear() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
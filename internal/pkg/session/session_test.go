@@ -0,0 +1,91 @@
+package session
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+func TestSaveLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		session Session
+		want    *Session
+	}{
+		{
+			name:    "home without drafts is not saved",
+			session: Session{Route: "/", Theme: "light"},
+		},
+		{
+			name:    "empty route is not saved",
+			session: Session{},
+		},
+		{
+			name:    "route, history, theme and scroll",
+			session: Session{Route: "/langs/golang", History: []string{"/", "/langs"}, Theme: "light", Scroll: map[string]int{"/langs": 3}},
+			want:    &Session{Route: "/langs/golang", History: []string{"/", "/langs"}, Theme: "light", Scroll: map[string]int{"/langs": 3}},
+		},
+		{
+			name:    "draft on the home page is saved",
+			session: Session{Route: "/", Drafts: map[string]url.Values{"/langs/golang/blueprint": {"name": {"api"}, "feature": {"docker", "htmx"}}}},
+			want:    &Session{Route: "/", Drafts: map[string]url.Values{"/langs/golang/blueprint": {"name": {"api"}, "feature": {"docker", "htmx"}}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_STATE_HOME", t.TempDir())
+			if err := Save(&tt.session); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			got, err := Load()
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("Load() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.SavedAt.IsZero() {
+				t.Fatalf("Load() = %+v, want a saved session", got)
+			}
+			got.SavedAt = tt.want.SavedAt
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+func TestSaveEmptyClears(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	if err := Save(&Session{Route: "/tasks"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := Save(&Session{Route: "/"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	path, err := Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Stat(%s) error = %v, want the file removed", path, err)
+	}
+}
+func TestLoadCorrupt(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	path, err := Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := Load(); err == nil {
+		t.Errorf("Load() = %+v, want an error", s)
+	}
+}
@@ -0,0 +1,200 @@
+package tasks
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+)
+type EventType string
+const (
+	EventStart  EventType = "start"
+	EventOutput EventType = "output"
+	EventSkip   EventType = "skip"
+	EventDone   EventType = "done"
+	EventFail   EventType = "fail"
+)
+type Event struct {
+	Task     string
+	Type     EventType
+	Line     string
+	Err      error
+	Duration time.Duration
+}
+type Status string
+const (
+	StatusOK       Status = "ok"
+	StatusUpToDate Status = "up-to-date"
+	StatusFailed   Status = "failed"
+	StatusSkipped  Status = "skipped"
+)
+type Result struct {
+	Task     string `json:"task" yaml:"task"`
+	Status   Status `json:"status" yaml:"status"`
+	Duration string `json:"duration" yaml:"duration"`
+	Output   string `json:"output,omitempty" yaml:"output,omitempty"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+type Runner struct {
+	file     *File
+	parallel int
+	force    bool
+	handler  func(Event)
+	mu       sync.Mutex
+}
+func NewRunner(file *File) *Runner {
+	return &Runner{
+		file:     file,
+		parallel: runtime.NumCPU(),
+	}
+}
+func (r *Runner) WithParallel(parallel int) *Runner {
+	if parallel > 0 {
+		r.parallel = parallel
+	}
+	return r
+}
+func (r *Runner) WithForce(force bool) *Runner {
+	r.force = force
+	return r
+}
+func (r *Runner) WithHandler(handler func(Event)) *Runner {
+	r.handler = handler
+	return r
+}
+func (r *Runner) emit(event Event) {
+	if r.handler == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handler(event)
+}
+func (r *Runner) Run(ctx context.Context, names ...string) ([]Result, error) {
+	plan, err := r.file.Plan(names...)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]Result, len(plan))
+	index := make(map[string]int, len(plan))
+	done := make(map[string]chan struct{}, len(plan))
+	for i, task := range plan {
+		index[task.Name] = i
+		done[task.Name] = make(chan struct{})
+	}
+	slots := make(chan struct{}, r.parallel)
+	var wg sync.WaitGroup
+	for i, task := range plan {
+		wg.Add(1)
+		go func(i int, task *Task) {
+			defer wg.Done()
+			defer close(done[task.Name])
+			for _, dep := range task.Deps {
+				<-done[dep]
+				if status := results[index[dep]].Status; status == StatusFailed || status == StatusSkipped {
+					results[i] = Result{Task: task.Name, Status: StatusSkipped, Error: fmt.Sprintf("dependency %s did not succeed", dep)}
+					r.emit(Event{Task: task.Name, Type: EventSkip, Line: results[i].Error})
+					return
+				}
+			}
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = Result{Task: task.Name, Status: StatusSkipped, Error: ctx.Err().Error()}
+				r.emit(Event{Task: task.Name, Type: EventSkip, Line: results[i].Error})
+				return
+			}
+			defer func() { <-slots }()
+			results[i] = r.runTask(ctx, task)
+		}(i, task)
+	}
+	wg.Wait()
+	for _, result := range results {
+		if result.Status == StatusFailed {
+			return results, fmt.Errorf("task %s failed: %s", result.Task, result.Error)
+		}
+	}
+	if ctx.Err() != nil {
+		return results, ctx.Err()
+	}
+	return results, nil
+}
+func (r *Runner) runTask(ctx context.Context, task *Task) Result {
+	start := time.Now()
+	dir := task.WorkingDir(r.file.Dir)
+	if !r.force && upToDate(task, dir) {
+		r.emit(Event{Task: task.Name, Type: EventSkip, Line: "up to date"})
+		return Result{Task: task.Name, Status: StatusUpToDate, Duration: "0s"}
+	}
+	r.emit(Event{Task: task.Name, Type: EventStart})
+	out := executor.NewLineWriter(func(line string) {
+		r.emit(Event{Task: task.Name, Type: EventOutput, Line: line})
+	})
+	for _, command := range task.Cmds {
+		out.WriteLine("$ " + command)
+		result := executor.NewExecutor().
+			WithWorkingDir(dir).
+			WithTimeout(0).
+			WithEnv(task.Environ()).
+			WithOutput(out, out).
+			ExecuteShell(ctx, command)
+		out.Flush()
+		if result.Failed() {
+			err := result.Error
+			if err == nil {
+				err = fmt.Errorf("exit code %d", result.ExitCode)
+			}
+			duration := time.Since(start)
+			r.emit(Event{Task: task.Name, Type: EventFail, Err: err, Duration: duration})
+			return Result{Task: task.Name, Status: StatusFailed, Duration: duration.Round(time.Millisecond).String(), Output: out.String(), Error: fmt.Sprintf("%s: %v", command, err)}
+		}
+	}
+	duration := time.Since(start)
+	r.emit(Event{Task: task.Name, Type: EventDone, Duration: duration})
+	return Result{Task: task.Name, Status: StatusOK, Duration: duration.Round(time.Millisecond).String(), Output: out.String()}
+}
+func upToDate(task *Task, dir string) bool {
+	if len(task.Inputs) == 0 || len(task.Outputs) == 0 {
+		return false
+	}
+	var newestInput time.Time
+	for _, pattern := range task.Inputs {
+		for _, info := range expand(dir, pattern) {
+			if info.ModTime().After(newestInput) {
+				newestInput = info.ModTime()
+			}
+		}
+	}
+	var oldestOutput time.Time
+	for _, pattern := range task.Outputs {
+		matches := expand(dir, pattern)
+		if len(matches) == 0 {
+			return false
+		}
+		for _, info := range matches {
+			if oldestOutput.IsZero() || info.ModTime().Before(oldestOutput) {
+				oldestOutput = info.ModTime()
+			}
+		}
+	}
+	return !newestInput.After(oldestOutput)
+}
+func expand(dir, pattern string) []os.FileInfo {
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(dir, pattern)
+	}
+	matches, _ := filepath.Glob(pattern)
+	var infos []os.FileInfo
+	for _, match := range matches {
+		filepath.Walk(match, func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				infos = append(infos, info)
+			}
+			return nil
+		})
+	}
+	return infos
+}
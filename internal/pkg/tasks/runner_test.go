@@ -0,0 +1,148 @@
+package tasks
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+func TestUpToDate(t *testing.T) {
+	base := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name  string
+		files map[string]time.Duration
+		task  Task
+		want  bool
+	}{
+		{
+			name:  "no inputs or outputs",
+			files: map[string]time.Duration{"main.go": 0},
+			task:  Task{Inputs: []string{"main.go"}},
+			want:  false,
+		},
+		{
+			name:  "output newer than inputs",
+			files: map[string]time.Duration{"main.go": 0, "util.go": time.Minute, "bin/app": 2 * time.Minute},
+			task:  Task{Inputs: []string{"*.go"}, Outputs: []string{"bin/app"}},
+			want:  true,
+		},
+		{
+			name:  "same modification time",
+			files: map[string]time.Duration{"main.go": time.Minute, "bin/app": time.Minute},
+			task:  Task{Inputs: []string{"main.go"}, Outputs: []string{"bin/app"}},
+			want:  true,
+		},
+		{
+			name:  "input newer than output",
+			files: map[string]time.Duration{"main.go": 2 * time.Minute, "bin/app": time.Minute},
+			task:  Task{Inputs: []string{"main.go"}, Outputs: []string{"bin/app"}},
+			want:  false,
+		},
+		{
+			name:  "missing output",
+			files: map[string]time.Duration{"main.go": 0},
+			task:  Task{Inputs: []string{"main.go"}, Outputs: []string{"bin/app"}},
+			want:  false,
+		},
+		{
+			name:  "oldest output decides",
+			files: map[string]time.Duration{"main.go": time.Minute, "bin/app": 2 * time.Minute, "bin/old": 0},
+			task:  Task{Inputs: []string{"main.go"}, Outputs: []string{"bin"}},
+			want:  false,
+		},
+		{
+			name:  "input directory is walked",
+			files: map[string]time.Duration{"pkg/a/a.go": 2 * time.Minute, "bin/app": time.Minute},
+			task:  Task{Inputs: []string{"pkg"}, Outputs: []string{"bin/app"}},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, offset := range tt.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, nil, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Chtimes(path, base.Add(offset), base.Add(offset)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := upToDate(&tt.task, dir); got != tt.want {
+				t.Errorf("upToDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+func TestRunnerRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the task commands use sh syntax")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".dev-tools.yaml")
+	err := os.WriteFile(path, []byte(`tasks:
+  gen:
+    cmds: ["echo generated > gen.txt"]
+    inputs: [gen.in]
+    outputs: [gen.txt]
+  build:
+    deps: [gen]
+    cmds: ["echo building $TARGET"]
+    env:
+      TARGET: linux
+  broken:
+    cmds: ["exit 3"]
+  release:
+    deps: [broken, build]
+    cmds: ["echo never"]
+`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "gen.in"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	results, err := NewRunner(file).WithParallel(1).WithHandler(func(event Event) {
+		if event.Type == EventOutput {
+			lines = append(lines, event.Task+": "+event.Line)
+		}
+	}).Run(context.Background(), "build")
+	if err != nil {
+		t.Fatalf("Run(build) error = %v", err)
+	}
+	if len(results) != 2 || results[0].Task != "gen" || results[1].Status != StatusOK {
+		t.Fatalf("Run(build) = %+v", results)
+	}
+	if got, want := lines[len(lines)-1], "build: building linux"; got != want {
+		t.Errorf("last output = %q, want %q", got, want)
+	}
+	results, err = NewRunner(file).Run(context.Background(), "gen")
+	if err != nil || results[0].Status != StatusUpToDate {
+		t.Errorf("second Run(gen) = %+v, %v, want up to date", results, err)
+	}
+	results, err = NewRunner(file).WithForce(true).Run(context.Background(), "gen")
+	if err != nil || results[0].Status != StatusOK {
+		t.Errorf("forced Run(gen) = %+v, %v, want ok", results, err)
+	}
+	results, err = NewRunner(file).Run(context.Background(), "release")
+	if err == nil {
+		t.Fatal("Run(release) error = nil, want the broken task to fail it")
+	}
+	statuses := make(map[string]Status)
+	for _, result := range results {
+		statuses[result.Task] = result.Status
+	}
+	if statuses["broken"] != StatusFailed || statuses["release"] != StatusSkipped {
+		t.Errorf("Run(release) statuses = %v", statuses)
+	}
+}
@@ -0,0 +1,159 @@
+// Package tasks loads project tasks from .dev-tools.yaml and runs them in dependency order
+package tasks
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"gopkg.in/yaml.v3"
+)
+var ErrUnknownTask = errors.New("unknown task")
+type Task struct {
+	Name        string            `yaml:"-" mapstructure:"-" json:"name"`
+	Description string            `yaml:"desc,omitempty" mapstructure:"desc" json:"desc,omitempty"`
+	Cmds        []string          `yaml:"cmds" mapstructure:"cmds" json:"cmds"`
+	Deps        []string          `yaml:"deps,omitempty" mapstructure:"deps" json:"deps,omitempty"`
+	Env         map[string]string `yaml:"env,omitempty" mapstructure:"env" json:"env,omitempty"`
+	Dir         string            `yaml:"dir,omitempty" mapstructure:"dir" json:"dir,omitempty"`
+	Inputs      []string          `yaml:"inputs,omitempty" mapstructure:"inputs" json:"inputs,omitempty"`
+	Outputs     []string          `yaml:"outputs,omitempty" mapstructure:"outputs" json:"outputs,omitempty"`
+}
+type File struct {
+	Path  string
+	Dir   string
+	Tasks map[string]*Task
+}
+type TaskError struct {
+	Task  string
+	Field string
+	Err   error
+}
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %s: %v", e.Task, e.Err)
+}
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return New(path, nil), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		Tasks map[string]*Task `yaml:"tasks"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	file := New(path, doc.Tasks)
+	if errs := file.Check(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return file, nil
+}
+func New(path string, defs map[string]*Task) *File {
+	file := &File{Path: path, Dir: filepath.Dir(path), Tasks: make(map[string]*Task)}
+	for name, task := range defs {
+		if task == nil {
+			task = &Task{}
+		}
+		task.Name = strings.ToLower(name)
+		for i, dep := range task.Deps {
+			task.Deps[i] = strings.ToLower(dep)
+		}
+		file.Tasks[task.Name] = task
+	}
+	return file
+}
+func (f *File) Check() []error {
+	var errs []error
+	for _, name := range f.Names() {
+		task := f.Tasks[name]
+		if len(task.Cmds) == 0 && len(task.Deps) == 0 {
+			errs = append(errs, &TaskError{Task: name, Err: errors.New("needs at least one of cmds or deps")})
+		}
+		for _, dep := range task.Deps {
+			if _, ok := f.Tasks[dep]; !ok {
+				errs = append(errs, &TaskError{Task: name, Field: "deps", Err: fmt.Errorf("depends on %w %q", ErrUnknownTask, dep)})
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	if _, err := f.Plan(f.Names()...); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+func (f *File) Names() []string {
+	names := make([]string, 0, len(f.Tasks))
+	for name := range f.Tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+func (f *File) Get(name string) (*Task, bool) {
+	task, ok := f.Tasks[strings.ToLower(name)]
+	return task, ok
+}
+func (f *File) Plan(names ...string) ([]*Task, error) {
+	var plan []*Task
+	state := make(map[string]int)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		name = strings.ToLower(name)
+		task, ok := f.Tasks[name]
+		if !ok {
+			return fmt.Errorf("%w %q", ErrUnknownTask, name)
+		}
+		switch state[name] {
+		case 1:
+			return fmt.Errorf("dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case 2:
+			return nil
+		}
+		state[name] = 1
+		for _, dep := range task.Deps {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = 2
+		plan = append(plan, task)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+func (t *Task) WorkingDir(base string) string {
+	if t.Dir == "" {
+		return base
+	}
+	if filepath.IsAbs(t.Dir) {
+		return t.Dir
+	}
+	return filepath.Join(base, t.Dir)
+}
+func (t *Task) Environ() []string {
+	env := os.Environ()
+	keys := make([]string, 0, len(t.Env))
+	for key := range t.Env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		env = append(env, key+"="+os.ExpandEnv(t.Env[key]))
+	}
+	return env
+}
@@ -0,0 +1,86 @@
+package tasks
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+func newFile(deps map[string][]string) *File {
+	file := &File{Tasks: make(map[string]*Task)}
+	for name, taskDeps := range deps {
+		file.Tasks[name] = &Task{Name: name, Deps: taskDeps}
+	}
+	return file
+}
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name  string
+		deps  map[string][]string
+		tasks []string
+		want  []string
+		err   string
+	}{
+		{
+			name:  "single task",
+			deps:  map[string][]string{"build": nil},
+			tasks: []string{"build"},
+			want:  []string{"build"},
+		},
+		{
+			name:  "chain runs dependencies first",
+			deps:  map[string][]string{"gen": nil, "build": {"gen"}, "test": {"build"}},
+			tasks: []string{"test"},
+			want:  []string{"gen", "build", "test"},
+		},
+		{
+			name:  "diamond runs a shared dependency once",
+			deps:  map[string][]string{"all": {"a", "b"}, "a": {"c"}, "b": {"c"}, "c": nil},
+			tasks: []string{"all"},
+			want:  []string{"c", "a", "b", "all"},
+		},
+		{
+			name:  "several targets share the plan",
+			deps:  map[string][]string{"a": {"c"}, "b": {"c"}, "c": nil},
+			tasks: []string{"a", "b"},
+			want:  []string{"c", "a", "b"},
+		},
+		{
+			name:  "unknown task",
+			deps:  map[string][]string{"build": nil},
+			tasks: []string{"deploy"},
+			err:   `unknown task "deploy"`,
+		},
+		{
+			name:  "cycle",
+			deps:  map[string][]string{"a": {"b"}, "b": {"a"}},
+			tasks: []string{"a"},
+			err:   "dependency cycle: a -> b -> a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := newFile(tt.deps).Plan(tt.tasks...)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Plan() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+			var got []string
+			for _, task := range plan {
+				got = append(got, task.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Plan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+func TestPlanUnknownTaskIsErrUnknownTask(t *testing.T) {
+	_, err := newFile(nil).Plan("missing")
+	if !errors.Is(err, ErrUnknownTask) {
+		t.Errorf("Plan() error = %v, want ErrUnknownTask", err)
+	}
+}
@@ -0,0 +1,149 @@
+// Package watch rebuilds and restarts a Go service when its sources change
+package watch
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/xdg"
+	"github.com/subosito/gotenv"
+)
+var (
+	DefaultInclude = []string{"**/*.go", "go.mod", "go.sum", "**/*.tmpl", "**/*.html"}
+	DefaultExclude = []string{".git/**", "vendor/**", "node_modules/**", "bin/**", "tmp/**", "**/*_test.go"}
+)
+type Config struct {
+	Dir         string        `mapstructure:"dir" json:"dir" yaml:"dir"`
+	Package     string        `mapstructure:"package" json:"package" yaml:"package"`
+	Bin         string        `mapstructure:"bin" json:"bin" yaml:"bin"`
+	Args        []string      `mapstructure:"args" json:"args,omitempty" yaml:"args,omitempty"`
+	Include     []string      `mapstructure:"include" json:"include" yaml:"include"`
+	Exclude     []string      `mapstructure:"exclude" json:"exclude" yaml:"exclude"`
+	Pre         []string      `mapstructure:"pre" json:"pre,omitempty" yaml:"pre,omitempty"`
+	Post        []string      `mapstructure:"post" json:"post,omitempty" yaml:"post,omitempty"`
+	EnvFile     string        `mapstructure:"env_file" json:"env_file,omitempty" yaml:"env_file,omitempty"`
+	Debounce    time.Duration `mapstructure:"debounce" json:"debounce" yaml:"debounce"`
+	StopTimeout time.Duration `mapstructure:"stop_timeout" json:"stop_timeout" yaml:"stop_timeout"`
+}
+func (c Config) WithDefaults() Config {
+	if c.Dir == "" {
+		c.Dir = "."
+	}
+	if abs, err := filepath.Abs(c.Dir); err == nil {
+		c.Dir = abs
+	}
+	if c.Package == "" {
+		c.Package = "."
+	}
+	if c.Bin == "" {
+		name := filepath.Base(c.Dir)
+		if runtime.GOOS == "windows" {
+			name += ".exe"
+		}
+		cache, err := xdg.CacheDir()
+		if err != nil {
+			cache = filepath.Join(os.TempDir(), xdg.AppName)
+		}
+		c.Bin = filepath.Join(cache, "watch", name)
+	}
+	if !filepath.IsAbs(c.Bin) {
+		c.Bin = filepath.Join(c.Dir, c.Bin)
+	}
+	if c.EnvFile != "" {
+		if !filepath.IsAbs(c.EnvFile) {
+			c.EnvFile = filepath.Join(c.Dir, c.EnvFile)
+		}
+		c.EnvFile = filepath.Clean(c.EnvFile)
+	}
+	if len(c.Include) == 0 {
+		c.Include = DefaultInclude
+	}
+	if c.Exclude == nil {
+		c.Exclude = DefaultExclude
+	}
+	if c.Debounce <= 0 {
+		c.Debounce = 300 * time.Millisecond
+	}
+	if c.StopTimeout <= 0 {
+		c.StopTimeout = 5 * time.Second
+	}
+	return c
+}
+func (c Config) Environ() ([]string, error) {
+	env := os.Environ()
+	if c.EnvFile == "" {
+		return env, nil
+	}
+	path := c.EnvFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.Dir, path)
+	}
+	values, err := gotenv.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		env = append(env, key+"="+values[key])
+	}
+	return env, nil
+}
+func (c Config) Matches(rel string) bool {
+	rel = filepath.ToSlash(rel)
+	if c.excluded(rel) {
+		return false
+	}
+	for _, pattern := range c.Include {
+		if Match(pattern, rel) {
+			return true
+		}
+	}
+	return false
+}
+func (c Config) excluded(rel string) bool {
+	for _, pattern := range c.Exclude {
+		if Match(pattern, rel) || Match(strings.TrimSuffix(pattern, "/**"), rel) {
+			return true
+		}
+	}
+	return false
+}
+func Match(pattern, path string) bool {
+	re, err := regexp.Compile(globToRegexp(pattern))
+	if err != nil {
+		return false
+	}
+	return re.MatchString(path)
+}
+func globToRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	pattern = filepath.ToSlash(pattern)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**/") {
+				b.WriteString("(.*/)?")
+				i += 2
+			} else if strings.HasPrefix(pattern[i:], "**") {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package watch
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "internal/app/app.go", true},
+		{"**/*.go", "main.go.orig", false},
+		{"*.go", "internal/app.go", false},
+		{"go.mod", "go.mod", true},
+		{"go.mod", "sub/go.mod", false},
+		{"vendor/**", "vendor/a/b.go", true},
+		{"vendor/**", "vendored/a.go", false},
+		{"cmd/?pi/*.go", "cmd/api/main.go", true},
+		{"cmd/?pi/*.go", "cmd/rpi2/main.go", false},
+		{"web/*.tmpl", "web/index.tmpl", true},
+		{"a+b.go", "a+b.go", true},
+		{"a+b.go", "aab.go", false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+func TestMatches(t *testing.T) {
+	cfg := Config{}.WithDefaults()
+	tests := map[string]bool{
+		"main.go":                  true,
+		"internal/server/route.go": true,
+		"go.sum":                   true,
+		"web/templates/page.tmpl":  true,
+		"main_test.go":             false,
+		"vendor/x/y.go":            false,
+		"vendor":                   false,
+		".git/HEAD":                false,
+		"bin/app":                  false,
+		"README.md":                false,
+	}
+	for path, want := range tests {
+		if got := cfg.Matches(path); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", path, got, want)
+		}
+	}
+	custom := Config{Include: []string{"**/*.sql"}, Exclude: []string{}}.WithDefaults()
+	if !custom.Matches("migrations/001.sql") || custom.Matches("main.go") {
+		t.Error("a custom include list should replace the defaults")
+	}
+	if !custom.Matches("bin/seed.sql") {
+		t.Error("an empty exclude list should not fall back to the defaults")
+	}
+}
+func TestWithDefaults(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Config{Dir: dir, Bin: "bin/api"}.WithDefaults()
+	if cfg.Bin != filepath.Join(dir, "bin", "api") {
+		t.Errorf("Bin = %q, want it relative to %s", cfg.Bin, dir)
+	}
+	if cfg.Package != "." || cfg.Debounce != 300*time.Millisecond || cfg.StopTimeout != 5*time.Second {
+		t.Errorf("WithDefaults() = %+v", cfg)
+	}
+	if !slices.Equal(cfg.Include, DefaultInclude) || !slices.Equal(cfg.Exclude, DefaultExclude) {
+		t.Errorf("Include/Exclude = %v/%v, want the defaults", cfg.Include, cfg.Exclude)
+	}
+	for envFile, want := range map[string]string{
+		".env":             filepath.Join(dir, ".env"),
+		"./config/../.env": filepath.Join(dir, ".env"),
+		"/etc/app/env":     filepath.Clean("/etc/app/env"),
+		"":                 "",
+	} {
+		if got := (Config{Dir: dir, EnvFile: envFile}).WithDefaults().EnvFile; got != want {
+			t.Errorf("EnvFile %q = %q, want %q", envFile, got, want)
+		}
+	}
+	cache := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cache)
+	kept := Config{Dir: dir, Debounce: time.Second}.WithDefaults()
+	if kept.Debounce != time.Second || filepath.Dir(kept.Bin) != filepath.Join(cache, "dev-tools", "watch") {
+		t.Errorf("WithDefaults() = %+v", kept)
+	}
+}
+func TestEnviron(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".env"), []byte("# local settings\nPORT=8080\nexport DSN=\"postgres://localhost/app\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	env, err := Config{Dir: dir, EnvFile: ".env"}.Environ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := env[len(env)-2:]; !slices.Equal(got, []string{"DSN=postgres://localhost/app", "PORT=8080"}) {
+		t.Errorf("Environ() ends with %q", got)
+	}
+	if _, err := (Config{Dir: dir, EnvFile: "missing.env"}).Environ(); err == nil {
+		t.Error("Environ() with a missing env file should fail")
+	}
+	env, err = Config{Dir: dir}.Environ()
+	if err != nil || len(env) != len(os.Environ()) {
+		t.Errorf("Environ() without an env file = %d entries, %v", len(env), err)
+	}
+}
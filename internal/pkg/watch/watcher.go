@@ -0,0 +1,264 @@
+package watch
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"github.com/danielscoffee/dev-tools/internal/pkg/executor"
+	"github.com/fsnotify/fsnotify"
+)
+type EventType string
+const (
+	EventChange      EventType = "change"
+	EventBuild       EventType = "build"
+	EventBuildFailed EventType = "build_failed"
+	EventStart       EventType = "start"
+	EventStop        EventType = "stop"
+	EventExit        EventType = "exit"
+	EventOutput      EventType = "output"
+	EventError       EventType = "error"
+)
+type Event struct {
+	Type     EventType
+	Files    []string
+	Line     string
+	Output   string
+	Err      error
+	PID      int
+	Duration time.Duration
+}
+type process struct {
+	cmd      *exec.Cmd
+	exited   chan struct{}
+	stopping atomic.Bool
+}
+type Watcher struct {
+	cfg     Config
+	handler func(Event)
+	mu      sync.Mutex
+	rebuild chan struct{}
+	proc    *process
+}
+func New(cfg Config) *Watcher {
+	return &Watcher{
+		cfg:     cfg.WithDefaults(),
+		rebuild: make(chan struct{}, 1),
+	}
+}
+func (w *Watcher) WithHandler(handler func(Event)) *Watcher {
+	w.handler = handler
+	return w
+}
+func (w *Watcher) Config() Config {
+	return w.cfg
+}
+func (w *Watcher) Rebuild() {
+	select {
+	case w.rebuild <- struct{}{}:
+	default:
+	}
+}
+func (w *Watcher) emit(event Event) {
+	if w.handler == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handler(event)
+}
+func (w *Watcher) Run(ctx context.Context) error {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start file watcher: %w", err)
+	}
+	defer fw.Close()
+	if err := w.addDirs(fw, w.cfg.Dir); err != nil {
+		return err
+	}
+	defer w.stop()
+	w.cycle(ctx)
+	timer := time.NewTimer(w.cfg.Debounce)
+	timer.Stop()
+	pending := make(map[string]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-fw.Events:
+			if !ok {
+				return nil
+			}
+			rel, err := filepath.Rel(w.cfg.Dir, event.Name)
+			if err != nil {
+				continue
+			}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					w.addDirs(fw, event.Name)
+					continue
+				}
+			}
+			if event.Has(fsnotify.Chmod) || !(w.cfg.Matches(rel) || w.isEnvFile(event.Name)) {
+				continue
+			}
+			pending[filepath.ToSlash(rel)] = true
+			timer.Reset(w.cfg.Debounce)
+		case err, ok := <-fw.Errors:
+			if !ok {
+				return nil
+			}
+			w.emit(Event{Type: EventError, Err: err})
+		case <-timer.C:
+			files := make([]string, 0, len(pending))
+			for file := range pending {
+				files = append(files, file)
+			}
+			sort.Strings(files)
+			pending = make(map[string]bool)
+			w.emit(Event{Type: EventChange, Files: files})
+			w.cycle(ctx)
+		case <-w.rebuild:
+			w.cycle(ctx)
+		}
+	}
+}
+func (w *Watcher) isEnvFile(path string) bool {
+	if w.cfg.EnvFile == "" {
+		return false
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return filepath.Clean(path) == w.cfg.EnvFile
+}
+func (w *Watcher) addDirs(fw *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if rel, err := filepath.Rel(w.cfg.Dir, path); err == nil && rel != "." && w.cfg.excluded(filepath.ToSlash(rel)) {
+			return filepath.SkipDir
+		}
+		if err := fw.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+		return nil
+	})
+}
+func (w *Watcher) cycle(ctx context.Context) {
+	env, err := w.cfg.Environ()
+	if err != nil {
+		w.emit(Event{Type: EventError, Err: fmt.Errorf("failed to read env file: %w", err)})
+		return
+	}
+	for _, command := range w.cfg.Pre {
+		if output, err := w.shell(ctx, env, command); err != nil {
+			w.emit(Event{Type: EventBuildFailed, Output: output, Err: fmt.Errorf("pre command %q failed: %w", command, err)})
+			return
+		}
+	}
+	start := time.Now()
+	w.emit(Event{Type: EventBuild})
+	if err := os.MkdirAll(filepath.Dir(w.cfg.Bin), 0o755); err != nil {
+		w.emit(Event{Type: EventBuildFailed, Err: err})
+		return
+	}
+	result := executor.NewExecutor().
+		WithWorkingDir(w.cfg.Dir).
+		WithTimeout(0).
+		WithEnv(env).
+		Execute(ctx, "go", "build", "-o", w.cfg.Bin, w.cfg.Package)
+	if ctx.Err() != nil {
+		return
+	}
+	if result.Failed() {
+		w.emit(Event{Type: EventBuildFailed, Output: result.Output(), Err: result.Error, Duration: time.Since(start)})
+		return
+	}
+	w.stop()
+	if err := w.start(env); err != nil {
+		w.emit(Event{Type: EventError, Err: err})
+		return
+	}
+	for _, command := range w.cfg.Post {
+		if _, err := w.shell(ctx, env, command); err != nil {
+			w.emit(Event{Type: EventError, Err: fmt.Errorf("post command %q failed: %w", command, err)})
+		}
+	}
+}
+func (w *Watcher) shell(ctx context.Context, env []string, command string) (string, error) {
+	out := executor.NewLineWriter(func(line string) {
+		w.emit(Event{Type: EventOutput, Line: line})
+	})
+	out.WriteLine("$ " + command)
+	result := executor.NewExecutor().
+		WithWorkingDir(w.cfg.Dir).
+		WithTimeout(0).
+		WithEnv(env).
+		WithOutput(out, out).
+		ExecuteShell(ctx, command)
+	out.Flush()
+	if result.Failed() {
+		if result.Error != nil {
+			return out.String(), result.Error
+		}
+		return out.String(), fmt.Errorf("exit code %d", result.ExitCode)
+	}
+	return out.String(), nil
+}
+func (w *Watcher) start(env []string) error {
+	out := executor.NewLineWriter(func(line string) {
+		w.emit(Event{Type: EventOutput, Line: line})
+	})
+	cmd, err := executor.NewExecutor().
+		WithWorkingDir(w.cfg.Dir).
+		WithEnv(env).
+		WithOutput(out, out).
+		Start(w.cfg.Bin, w.cfg.Args...)
+	if err != nil {
+		return fmt.Errorf("failed to start %s: %w", w.cfg.Bin, err)
+	}
+	proc := &process{cmd: cmd, exited: make(chan struct{})}
+	w.proc = proc
+	w.emit(Event{Type: EventStart, PID: cmd.Process.Pid})
+	go func() {
+		err := cmd.Wait()
+		out.Flush()
+		close(proc.exited)
+		if !proc.stopping.Load() {
+			w.emit(Event{Type: EventExit, PID: cmd.Process.Pid, Err: err})
+		}
+	}()
+	return nil
+}
+func (w *Watcher) stop() {
+	proc := w.proc
+	if proc == nil {
+		return
+	}
+	w.proc = nil
+	select {
+	case <-proc.exited:
+		return
+	default:
+	}
+	proc.stopping.Store(true)
+	if runtime.GOOS == "windows" {
+		proc.cmd.Process.Kill()
+	} else {
+		proc.cmd.Process.Signal(os.Interrupt)
+	}
+	select {
+	case <-proc.exited:
+	case <-time.After(w.cfg.StopTimeout):
+		proc.cmd.Process.Kill()
+		<-proc.exited
+	}
+	w.emit(Event{Type: EventStop, PID: proc.cmd.Process.Pid})
+}
@@ -0,0 +1,24 @@
+package watch
+import (
+	"path/filepath"
+	"testing"
+)
+func TestIsEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	w := New(Config{Dir: ".", EnvFile: "./config/../.env"})
+	for path, want := range map[string]bool{
+		filepath.Join(dir, ".env"):                 true,
+		filepath.Join(dir, "config", "..", ".env"): true,
+		".env":                            true,
+		filepath.Join(dir, "sub", ".env"): false,
+		filepath.Join(dir, ".env.local"):  false,
+	} {
+		if got := w.isEnvFile(path); got != want {
+			t.Errorf("isEnvFile(%q) = %v, want %v", path, got, want)
+		}
+	}
+	if New(Config{Dir: dir}).isEnvFile(filepath.Join(dir, ".env")) {
+		t.Error("isEnvFile() without an env file should be false")
+	}
+}
@@ -0,0 +1,34 @@
+// Package xdg resolves the dev-tools config, state and cache directories following the XDG base directory spec
+package xdg
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+const AppName = "dev-tools"
+func ConfigDir() (string, error) {
+	return dir("XDG_CONFIG_HOME", ".config", os.UserConfigDir)
+}
+func StateDir() (string, error) {
+	return dir("XDG_STATE_HOME", filepath.Join(".local", "state"), os.UserConfigDir)
+}
+func CacheDir() (string, error) {
+	return dir("XDG_CACHE_HOME", ".cache", os.UserCacheDir)
+}
+func dir(env, fallback string, windows func() (string, error)) (string, error) {
+	if base := os.Getenv(env); filepath.IsAbs(base) {
+		return filepath.Join(base, AppName), nil
+	}
+	if runtime.GOOS == "windows" {
+		base, err := windows()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(base, AppName), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, fallback, AppName), nil
+}
@@ -0,0 +1,36 @@
+package xdg
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+func TestDirs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("windows resolves these through the known folders")
+	}
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("XDG_STATE_HOME", "relative/state")
+	t.Setenv("XDG_CACHE_HOME", "/var/cache")
+	tests := []struct {
+		name string
+		dir  func() (string, error)
+		want string
+	}{
+		{name: "config falls back to ~/.config", dir: ConfigDir, want: filepath.Join(home, ".config", AppName)},
+		{name: "relative state home is ignored", dir: StateDir, want: filepath.Join(home, ".local", "state", AppName)},
+		{name: "absolute cache home is used", dir: CacheDir, want: filepath.Join("/var/cache", AppName)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.dir()
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}